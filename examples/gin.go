@@ -31,9 +31,9 @@ func (record Record) Type() string {
 
 func getRecords(ctx *gin.Context) {
 
-	ctx.JSON(200, jsonapi.CreateCollectionResponse(ctx.Request)(jsonapi.CollectionResponse{
+	jsonapi.WriteCollection(ctx.Writer, ctx.Request, jsonapi.CollectionResponse{
 		Nodes: records(),
-	}))
+	})
 }
 
 func getRecord(ctx *gin.Context) {
@@ -47,9 +47,9 @@ func getRecord(ctx *gin.Context) {
 		}
 	}
 
-	ctx.JSON(200, jsonapi.CreateResponse(ctx.Request)(jsonapi.Response{
+	jsonapi.Write(ctx.Writer, ctx.Request, jsonapi.Response{
 		Node: record,
-	}))
+	})
 }
 
 func records() []Record {
@@ -16,19 +16,47 @@ const (
 	LastKey string = "last"
 	// RelatedKey represents the key for the related link
 	RelatedKey string = "related"
+	// AboutKey represents the key for the error link to further details about the particular occurrence of the problem
+	AboutKey string = "about"
+	// TypeKey represents the key for the error link that identifies the type of error of the particular problem
+	TypeKey string = "type"
 )
 
 // Include query parameter used to request extra resources to include in response
 const Include string = "include"
 
+// SortParameter query parameter used to request the order of resources in response
+const SortParameter string = "sort"
+
+// FilterParameter query parameter family used to filter resources in response: filter[FIELD]
+const FilterParameter string = "filter"
+
+// Fields query parameter family used to request sparse fieldsets in response: fields[TYPE]
+const Fields string = "fields"
+
 // HTTP Header keys and values
 const (
 	// ContentType is the standard Content-Type header.
 	ContentType string = "Content-Type"
 	// MediaType is the standard JSON:API media type for the Content-Type header.
 	MediaType string = "application/vnd.api+json"
+	// Accept is the standard Accept header.
+	Accept string = "Accept"
 )
 
+// JSON:API media type parameters
+const (
+	// ExtParameter lists the extensions applied to a document.
+	ExtParameter string = "ext"
+	// ProfileParameter lists the profiles applied to a document.
+	ProfileParameter string = "profile"
+
+	qualityParameter string = "q"
+)
+
+// CursorPaginationProfile is the URI of the cursor pagination profile: https://jsonapi.org/profiles/ethanresnick/cursor-pagination/
+const CursorPaginationProfile string = "https://jsonapi.org/profiles/ethanresnick/cursor-pagination/"
+
 // Standard HTTP Headers
 const (
 	// ForwardedPrefix represents the prefix that is dropped when proxied through rest-api
@@ -57,6 +85,9 @@ const (
 	PageAfter PaginationOption = "page[after]"
 )
 
+// DefaultPageSize is the page size used by the paginators when neither the request nor the paginator provide one
+const DefaultPageSize int = 20
+
 // PaginationOptions is the array of all available PaginationOption items
 var PaginationOptions []PaginationOption = []PaginationOption{
 	PageOffset,
@@ -74,4 +105,14 @@ var (
 	ErrTooManyIncluded error = errors.New("included query has too many resources")
 	// ErrResourceNotAvailable member of included is not an available resource
 	ErrResourceNotAvailable error = errors.New("resource from included query not available")
+	// ErrNotMediaType media type is not the JSON:API media type
+	ErrNotMediaType error = errors.New("media type is not the JSON:API media type")
+	// ErrUnsupportedMediaTypeParameter JSON:API media type is modified by a parameter other than ext or profile
+	ErrUnsupportedMediaTypeParameter error = errors.New("media type parameter is not supported")
+	// ErrMalformedCursor cursor is not a cursor encoded by the CursorCodec
+	ErrMalformedCursor error = errors.New("cursor is malformed")
+	// ErrInvalidCursorSignature cursor was not signed with the signing key of the CursorCodec
+	ErrInvalidCursorSignature error = errors.New("cursor signature is invalid")
+	// ErrRelationshipShape relationship linkage is to-one where to-many is expected, or the other way around
+	ErrRelationshipShape error = errors.New("relationship linkage has the wrong shape")
 )
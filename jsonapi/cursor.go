@@ -0,0 +1,196 @@
+package jsonapi
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// CursorCodec encodes the sort key values of a resource into an opaque, URL-safe cursor and decodes them back,
+// so that database keys are neither exposed to nor forgeable by clients.
+//
+//	codec := jsonapi.CursorCodec{SigningKey: []byte(os.Getenv("CURSOR_KEY"))}
+//	cursor, err := codec.Encode(article.CreatedAt, article.ID)
+//	err = codec.Decode(page.After, &createdAt, &id)
+type CursorCodec struct {
+	// SigningKey signs cursors with HMAC-SHA256 when provided, cursors with an invalid signature fail to decode.
+	SigningKey []byte
+	// EncryptionKey encrypts cursors with AES-GCM when provided, must be 16, 24 or 32 bytes long.
+	EncryptionKey []byte
+}
+
+// InvalidCursorError is returned when decoding a malformed or tampered cursor.
+// It is converted by FromError into a 400 Bad Request Error with Source.Parameter set to the cursor's query parameter.
+type InvalidCursorError struct {
+	Parameter PaginationOption
+	Err       error
+}
+
+func (err *InvalidCursorError) Error() string {
+	return fmt.Sprintf("%s is not a valid cursor: %s", err.Parameter, err.Err)
+}
+
+func (err *InvalidCursorError) Unwrap() error {
+	return err.Err
+}
+
+// StatusCode implements StatusCoder
+func (err *InvalidCursorError) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// ErrorTitle implements ErrorTitler
+func (err *InvalidCursorError) ErrorTitle() string {
+	return "Invalid Cursor."
+}
+
+// ErrorSource implements ErrorSourcer
+func (err *InvalidCursorError) ErrorSource() ErrorSource {
+	return ErrorSource{
+		Parameter: err.Parameter.String(),
+	}
+}
+
+// Validate checks the keys of the codec, call it once when the codec is set up.
+// An invalid EncryptionKey is a misconfiguration of the server, which fails every Encode and Decode.
+func (codec CursorCodec) Validate() error {
+	if len(codec.EncryptionKey) == 0 {
+		return nil
+	}
+
+	_, err := codec.cipher()
+	return err
+}
+
+// Encode encodes the provided JSON serializable values into a cursor
+func (codec CursorCodec) Encode(values ...interface{}) (string, error) {
+	payload, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	if len(codec.EncryptionKey) > 0 {
+		if payload, err = codec.encrypt(payload); err != nil {
+			return "", err
+		}
+	}
+
+	if len(codec.SigningKey) > 0 {
+		payload = append(payload, codec.sign(payload)...)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload), nil
+}
+
+// Decode decodes a page[after] cursor into the provided pointers, in the order the values were encoded.
+// A malformed or tampered cursor will return an *InvalidCursorError.
+func (codec CursorCodec) Decode(cursor string, values ...interface{}) error {
+	return codec.DecodeParameter(PageAfter, cursor, values...)
+}
+
+// DecodeParameter decodes the cursor of the provided query parameter into the provided pointers, in the order the values were encoded.
+// A malformed or tampered cursor will return an *InvalidCursorError, while an invalid EncryptionKey returns the error of Validate as is.
+func (codec CursorCodec) DecodeParameter(option PaginationOption, cursor string, values ...interface{}) error {
+	if err := codec.Validate(); err != nil {
+		return err
+	}
+
+	payload, err := codec.open(cursor)
+	if err != nil {
+		return &InvalidCursorError{Parameter: option, Err: err}
+	}
+
+	var encoded []json.RawMessage
+	if err := json.Unmarshal(payload, &encoded); err != nil || len(encoded) != len(values) {
+		return &InvalidCursorError{Parameter: option, Err: ErrMalformedCursor}
+	}
+
+	for index, value := range values {
+		if err := json.Unmarshal(encoded[index], value); err != nil {
+			return &InvalidCursorError{Parameter: option, Err: ErrMalformedCursor}
+		}
+	}
+
+	return nil
+}
+
+// open decodes, verifies and decrypts the payload of the cursor
+func (codec CursorCodec) open(cursor string) ([]byte, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrMalformedCursor
+	}
+
+	if len(codec.SigningKey) > 0 {
+		if len(payload) < sha256.Size {
+			return nil, ErrInvalidCursorSignature
+		}
+
+		signature := payload[len(payload)-sha256.Size:]
+		payload = payload[:len(payload)-sha256.Size]
+		if !hmac.Equal(signature, codec.sign(payload)) {
+			return nil, ErrInvalidCursorSignature
+		}
+	}
+
+	if len(codec.EncryptionKey) > 0 {
+		return codec.decrypt(payload)
+	}
+
+	return payload, nil
+}
+
+func (codec CursorCodec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, codec.SigningKey)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+func (codec CursorCodec) encrypt(payload []byte) ([]byte, error) {
+	gcm, err := codec.cipher()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, payload, nil), nil
+}
+
+func (codec CursorCodec) decrypt(payload []byte) ([]byte, error) {
+	gcm, err := codec.cipher()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(payload) < gcm.NonceSize() {
+		return nil, ErrMalformedCursor
+	}
+
+	decrypted, err := gcm.Open(nil, payload[:gcm.NonceSize()], payload[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrMalformedCursor
+	}
+
+	return decrypted, nil
+}
+
+func (codec CursorCodec) cipher() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(codec.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("jsonapi: invalid cursor encryption key: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
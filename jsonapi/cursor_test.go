@@ -0,0 +1,122 @@
+package jsonapi_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CursorCodec(t *testing.T) {
+	codecs := map[string]jsonapi.CursorCodec{
+		"plain":     {},
+		"signed":    {SigningKey: []byte("signing-key")},
+		"encrypted": {EncryptionKey: []byte("0123456789abcdef")},
+		"both":      {SigningKey: []byte("signing-key"), EncryptionKey: []byte("0123456789abcdef0123456789abcdef")},
+	}
+
+	for name, codec := range codecs {
+		t.Run(name, func(t *testing.T) {
+			cursor, err := codec.Encode("2022-05-01T10:00:00Z", 1234)
+			assert.Nil(t, err)
+			assert.NotContains(t, cursor, "=")
+			assert.NotContains(t, cursor, "+")
+			assert.NotContains(t, cursor, "/")
+
+			var createdAt string
+			var id int
+			err = codec.Decode(cursor, &createdAt, &id)
+
+			assert.Nil(t, err)
+			assert.Equal(t, "2022-05-01T10:00:00Z", createdAt)
+			assert.Equal(t, 1234, id)
+		})
+	}
+}
+
+func Test_CursorCodec_Encrypted_Opaque(t *testing.T) {
+	codec := jsonapi.CursorCodec{EncryptionKey: []byte("0123456789abcdef")}
+
+	first, _ := codec.Encode("secret-key")
+	second, _ := codec.Encode("secret-key")
+
+	assert.NotEqual(t, first, second)
+	assert.NotContains(t, first, "c2VjcmV0")
+}
+
+func Test_CursorCodec_Encode_InvalidKey(t *testing.T) {
+	_, err := jsonapi.CursorCodec{EncryptionKey: []byte("short")}.Encode(1)
+
+	assert.NotNil(t, err)
+}
+
+func Test_CursorCodec_Validate(t *testing.T) {
+	assert.Nil(t, jsonapi.CursorCodec{}.Validate())
+	assert.Nil(t, jsonapi.CursorCodec{EncryptionKey: []byte("0123456789abcdef")}.Validate())
+	assert.NotNil(t, jsonapi.CursorCodec{EncryptionKey: []byte("short")}.Validate())
+}
+
+func Test_CursorCodec_Decode_InvalidKey(t *testing.T) {
+	cursor, _ := jsonapi.CursorCodec{}.Encode(1)
+
+	var id int
+	err := jsonapi.CursorCodec{EncryptionKey: []byte("short")}.Decode(cursor, &id)
+
+	var cursorErr *jsonapi.InvalidCursorError
+	assert.NotNil(t, err)
+	assert.False(t, errors.As(err, &cursorErr))
+
+	errs := jsonapi.FromError(err)
+	assert.Equal(t, http.StatusInternalServerError, errs[0].StatusCode())
+	assert.Nil(t, errs[0].Source)
+}
+
+func Test_CursorCodec_Decode_Tampered(t *testing.T) {
+	codec := jsonapi.CursorCodec{SigningKey: []byte("signing-key")}
+	cursor, _ := codec.Encode(1234)
+
+	tampered, _ := jsonapi.CursorCodec{SigningKey: []byte("other-key")}.Encode(1)
+
+	var id int
+	err := codec.Decode(tampered, &id)
+
+	var cursorErr *jsonapi.InvalidCursorError
+	assert.True(t, errors.As(err, &cursorErr))
+	assert.Equal(t, jsonapi.PageAfter, cursorErr.Parameter)
+	assert.True(t, errors.Is(err, jsonapi.ErrInvalidCursorSignature))
+
+	err = codec.Decode(strings.TrimSuffix(cursor, cursor[len(cursor)-2:])+"AA", &id)
+	assert.True(t, errors.Is(err, jsonapi.ErrInvalidCursorSignature))
+
+	err = codec.Decode("abc", &id)
+	assert.True(t, errors.Is(err, jsonapi.ErrInvalidCursorSignature))
+}
+
+func Test_CursorCodec_Decode_Malformed(t *testing.T) {
+	codec := jsonapi.CursorCodec{}
+	cursor, _ := codec.Encode("text")
+
+	var id int
+	assert.True(t, errors.Is(codec.Decode("not base64!", &id), jsonapi.ErrMalformedCursor))
+	assert.True(t, errors.Is(codec.Decode(cursor, &id), jsonapi.ErrMalformedCursor))
+	assert.True(t, errors.Is(codec.Decode(cursor, &id, &id), jsonapi.ErrMalformedCursor))
+
+	encrypted := jsonapi.CursorCodec{EncryptionKey: []byte("0123456789abcdef")}
+	assert.True(t, errors.Is(encrypted.Decode(cursor, &id), jsonapi.ErrMalformedCursor))
+}
+
+func Test_CursorCodec_DecodeParameter_FromError(t *testing.T) {
+	var id int
+	err := jsonapi.CursorCodec{}.DecodeParameter(jsonapi.PageBefore, "not base64!", &id)
+
+	errs := jsonapi.FromError(err)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, "Invalid Cursor.", errs[0].Title)
+	assert.Equal(t, "page[before] is not a valid cursor: cursor is malformed", errs[0].Detail)
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "page[before]"}, errs[0].Source)
+}
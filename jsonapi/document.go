@@ -0,0 +1,44 @@
+package jsonapi
+
+// document holds the members shared by Response and CollectionResponse during transformation
+type document struct {
+	primary    []Node
+	data       interface{} // internalNode | []internalNode
+	included   []Node
+	errors     Errors
+	links      Links
+	meta       interface{}
+	fields     Fieldsets
+	include    Included
+	includeAll bool
+	jsonapi    *JSONAPI
+}
+
+func (doc document) transform(baseURL string) TransformedResponse {
+	if doc.errors.HasErrors() {
+		doc.primary, doc.data, doc.included = nil, nil, nil
+	}
+
+	if doc.include != nil && !doc.includeAll {
+		tree := doc.include.Tree()
+		doc.errors = append(doc.errors, verifyIncluded(doc.primary, tree, "")...)
+		doc.included = collectIncluded(doc.primary, tree)
+	}
+
+	if !doc.errors.HasErrors() {
+		doc.errors = doc.fields.verifyNodes(append(doc.primary, doc.included...))
+	}
+
+	if doc.errors.HasErrors() {
+		doc.data, doc.included = nil, nil
+	}
+
+	return TransformedResponse{
+		JSONAPI:  transformJSONAPI(doc.jsonapi),
+		Data:     doc.fields.applyData(doc.data),
+		Included: doc.fields.applyNodes(transformIncluded(doc.included, doc.data, baseURL)),
+		Errors:   transformErrors(doc.errors, baseURL),
+		Links:    TransformLinks(doc.links, baseURL),
+		Meta:     doc.meta,
+	}
+}
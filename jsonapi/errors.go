@@ -1,21 +1,149 @@
 package jsonapi
 
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
 // ErrorSource is the standard JSONAPI Error Source struct
 type ErrorSource struct {
 	Pointer   string `json:"pointer,omitempty"`
 	Parameter string `json:"parameter,omitempty"`
+	Header    string `json:"header,omitempty"`
 }
 
-// Error is the standard JSONAPI Error struct
+// Error is the standard JSONAPI Error struct: https://jsonapi.org/format/#error-objects
 type Error struct {
-	ID     string      `json:"id,omitempty"`
-	Links  Links       `json:"links,omitempty"`
-	Status int         `json:"status,omitempty"`
-	Code   int         `json:"code,omitempty"`
-	Title  string      `json:"title,omitempty"`
-	Detail string      `json:"detail,omitempty"`
-	Source interface{} `json:"source,omitempty"` // ErrorSource
-	Meta   interface{} `json:"meta,omitempty"`
+	ID        string      `json:"id,omitempty"`
+	Links     Links       `json:"links,omitempty"`  // AboutKey | TypeKey
+	Status    ErrorStatus `json:"status,omitempty"` // HTTP status code, marshalled as a string
+	ErrorCode string      `json:"code,omitempty"`   // application-specific error code
+	Title     string      `json:"title,omitempty"`
+	Detail    string      `json:"detail,omitempty"`
+	Source    interface{} `json:"source,omitempty"` // ErrorSource
+	Meta      interface{} `json:"meta,omitempty"`
+	// Code is the numeric error code of JSON:API 1.0, marshalled as the string code when ErrorCode is empty.
+	//
+	// Deprecated: use ErrorCode instead.
+	Code int `json:"-"`
+}
+
+// ErrorStatus is the HTTP status code of an Error, marshalled as a string as of JSON:API 1.1.
+// Int constants can be assigned directly, ex. Error{Status: http.StatusNotFound}
+type ErrorStatus int
+
+// String formats the status as it is marshalled, empty when missing
+func (status ErrorStatus) String() string {
+	if status == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(int(status))
+}
+
+// MarshalJSON marshals the status as a string
+func (status ErrorStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(status.String())
+}
+
+// UnmarshalJSON accepts the string status of JSON:API 1.1 and the numeric status of earlier documents
+func (status *ErrorStatus) UnmarshalJSON(data []byte) error {
+	text, err := decodeStringOrNumber(data)
+	if err != nil {
+		return err
+	}
+
+	if len(text) == 0 {
+		*status = 0
+		return nil
+	}
+
+	code, err := strconv.Atoi(text)
+	if err != nil {
+		return fmt.Errorf("jsonapi: error status %q is not an HTTP status code", text)
+	}
+
+	*status = ErrorStatus(code)
+	return nil
+}
+
+// Status converts an HTTP status code into the status of an Error, ex. Status(http.StatusNotFound)
+func Status(code int) ErrorStatus {
+	return ErrorStatus(code)
+}
+
+// Code converts an int error code into the string code of an Error, ex. ErrorCode: Code(42)
+func Code(code int) string {
+	if code == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(code)
+}
+
+// MarshalJSON marshals the error object with the deprecated int Code as its code when ErrorCode is empty
+func (err Error) MarshalJSON() ([]byte, error) {
+	type plainError Error
+	plain := plainError(err)
+	plain.ErrorCode = err.code()
+
+	return json.Marshal(plain)
+}
+
+// UnmarshalJSON decodes an error object, accepting the numeric code of documents written before JSON:API 1.1
+func (err *Error) UnmarshalJSON(data []byte) error {
+	type plainError Error
+	var decoded struct {
+		plainError
+		ErrorCode json.RawMessage `json:"code"`
+	}
+
+	if decodeErr := json.Unmarshal(data, &decoded); decodeErr != nil {
+		return decodeErr
+	}
+
+	code, decodeErr := decodeStringOrNumber(decoded.ErrorCode)
+	if decodeErr != nil {
+		return decodeErr
+	}
+
+	*err = Error(decoded.plainError)
+	err.ErrorCode = code
+
+	return nil
+}
+
+func decodeStringOrNumber(value json.RawMessage) (string, error) {
+	if len(value) == 0 || string(value) == "null" {
+		return "", nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(value, &number); err == nil {
+		return number.String(), nil
+	}
+
+	var text string
+	err := json.Unmarshal(value, &text)
+	return text, err
+}
+
+// code returns ErrorCode, or the deprecated int Code as a string
+func (err Error) code() string {
+	if len(err.ErrorCode) > 0 {
+		return err.ErrorCode
+	}
+
+	return Code(err.Code)
+}
+
+// StatusCode returns the status of the Error as an int, 0 when missing
+func (err Error) StatusCode() int {
+	return int(err.Status)
 }
 
 // Errors is a standard array of JSONAPI Error structs
@@ -27,11 +155,151 @@ func (errs Errors) HasErrors() bool {
 	return len(errs) > 0
 }
 
+// Error implements the error interface with the title and detail of the Error
+func (err Error) Error() string {
+	var message []string
+	if len(err.Title) > 0 {
+		message = append(message, err.Title)
+	}
+	if len(err.Detail) > 0 {
+		message = append(message, err.Detail)
+	}
+
+	if len(message) == 0 {
+		if text := http.StatusText(err.StatusCode()); len(text) > 0 {
+			return text
+		}
+		return "unknown error"
+	}
+
+	return strings.Join(message, " ")
+}
+
+// Error implements the error interface by joining the message of every Error.
+// Be aware that returning nil Errors as an error will result in a non-nil error, check HasErrors first.
+func (errs Errors) Error() string {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// As gives errors.As access to the first Error that matches the target
+func (errs Errors) As(target interface{}) bool {
+	for _, err := range errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Unwrap exposes every Error to the traversal of error trees
+func (errs Errors) Unwrap() []error {
+	unwrapped := make([]error, 0, len(errs))
+	for _, err := range errs {
+		unwrapped = append(unwrapped, err)
+	}
+
+	return unwrapped
+}
+
+// StatusCoder can be implemented by custom errors to be converted by FromError with an HTTP status.
+// The error message is used as the detail of the resulting Error.
+type StatusCoder interface {
+	StatusCode() int
+}
+
+// ErrorCoder can be implemented by custom errors to be converted by FromError with an application-specific error code
+type ErrorCoder interface {
+	ErrorCode() string
+}
+
+// ErrorTitler can be implemented by custom errors to be converted by FromError with a title, defaults to the text of the status
+type ErrorTitler interface {
+	ErrorTitle() string
+}
+
+// ErrorSourcer can be implemented by custom errors to be converted by FromError with the source of the error
+type ErrorSourcer interface {
+	ErrorSource() ErrorSource
+}
+
+// FromError converts any error into Errors.
+// Error and Errors are unwrapped as is, custom errors implementing StatusCoder are converted with their status, code, title, source and message,
+// and any other error is converted into a generic 500 Internal Server Error that does not expose the error message.
+func FromError(err error) Errors {
+	if err == nil {
+		return nil
+	}
+
+	var errs Errors
+	if errors.As(err, &errs) {
+		return append(Errors{}, errs...)
+	}
+
+	var jsonapiError Error
+	if errors.As(err, &jsonapiError) {
+		return Errors{jsonapiError}
+	}
+
+	var jsonapiErrorPtr *Error
+	if errors.As(err, &jsonapiErrorPtr) && jsonapiErrorPtr != nil {
+		return Errors{*jsonapiErrorPtr}
+	}
+
+	var statusCoder StatusCoder
+	if errors.As(err, &statusCoder) {
+		return Errors{fromStatusCoder(statusCoder, err)}
+	}
+
+	return Errors{
+		{
+			Title:  "Internal Server Error.",
+			Status: Status(http.StatusInternalServerError),
+		},
+	}
+}
+
+func fromStatusCoder(statusCoder StatusCoder, err error) Error {
+	if statusError, isError := statusCoder.(error); isError {
+		err = statusError
+	}
+
+	status := statusCoder.StatusCode()
+
+	title := http.StatusText(status)
+	if titler, isTitler := statusCoder.(ErrorTitler); isTitler {
+		title = titler.ErrorTitle()
+	}
+
+	var code string
+	if coder, isCoder := statusCoder.(ErrorCoder); isCoder {
+		code = coder.ErrorCode()
+	}
+
+	var source interface{}
+	if sourcer, isSourcer := statusCoder.(ErrorSourcer); isSourcer {
+		source = sourcer.ErrorSource()
+	}
+
+	return Error{
+		Title:     title,
+		Detail:    err.Error(),
+		Source:    source,
+		Status:    Status(status),
+		ErrorCode: code,
+	}
+}
+
 type internalError struct {
 	ID     string      `json:"id,omitempty"`
 	Links  LinkMap     `json:"links,omitempty"`
-	Status int         `json:"status,omitempty"`
-	Code   int         `json:"code,omitempty"`
+	Status ErrorStatus `json:"status,omitempty"`
+	Code   string      `json:"code,omitempty"`
 	Title  string      `json:"title,omitempty"`
 	Detail string      `json:"detail,omitempty"`
 	Source interface{} `json:"source,omitempty"` // ErrorSource
@@ -53,7 +321,7 @@ func transformError(err Error, baseURL string) internalError {
 		ID:     err.ID,
 		Links:  TransformLinks(err.Links, baseURL),
 		Status: err.Status,
-		Code:   err.Code,
+		Code:   err.code(),
 		Title:  err.Title,
 		Detail: err.Detail,
 		Source: err.Source,
@@ -1,6 +1,11 @@
 package jsonapi
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -69,3 +74,230 @@ func Test_transformError(t *testing.T) {
 	assert.NotNil(t, transformed.Links[RelatedKey])
 	assert.Equal(t, baseURL+err.Links[RelatedKey].Href, transformed.Links[RelatedKey])
 }
+
+func Test_Error_Error(t *testing.T) {
+	assert.Equal(t, "Invalid Attribute. age must be a number", Error{Title: "Invalid Attribute.", Detail: "age must be a number"}.Error())
+	assert.Equal(t, "Invalid Attribute.", Error{Title: "Invalid Attribute."}.Error())
+	assert.Equal(t, "Not Found", Error{Status: Status(http.StatusNotFound)}.Error())
+	assert.Equal(t, "unknown error", Error{}.Error())
+}
+
+func Test_Errors_Error(t *testing.T) {
+	errs := Errors{{Title: "First."}, {Title: "Second.", Detail: "with detail"}}
+
+	assert.Equal(t, "First.; Second. with detail", errs.Error())
+}
+
+func Test_Errors_As(t *testing.T) {
+	var err error = fmt.Errorf("wrapped: %w", Errors{{Title: "Not Found.", Status: Status(http.StatusNotFound)}})
+
+	var target Error
+	assert.True(t, errors.As(err, &target))
+	assert.Equal(t, http.StatusNotFound, target.StatusCode())
+}
+
+func Test_Errors_As_Method(t *testing.T) {
+	errs := Errors{{Title: "Conflict.", Status: Status(http.StatusConflict)}}
+
+	var target Error
+	assert.True(t, errs.As(&target))
+	assert.Equal(t, http.StatusConflict, target.StatusCode())
+
+	var statusCoder StatusCoder
+	assert.True(t, errs.As(&statusCoder))
+
+	var pathErr *os.PathError
+	assert.False(t, errs.As(&pathErr))
+}
+
+type testStatusError struct {
+	message string
+}
+
+func (err testStatusError) Error() string {
+	return err.message
+}
+
+func (err testStatusError) StatusCode() int {
+	return http.StatusConflict
+}
+
+type testCodedError struct {
+	testStatusError
+}
+
+func (err testCodedError) ErrorCode() string {
+	return "E_RESOURCE_LOCKED"
+}
+
+func (err testCodedError) ErrorTitle() string {
+	return "Resource Locked."
+}
+
+func Test_FromError_Nil(t *testing.T) {
+	assert.Nil(t, FromError(nil))
+}
+
+func Test_FromError_Error(t *testing.T) {
+	notFound := Error{Title: "Not Found.", Status: Status(http.StatusNotFound)}
+
+	assert.Equal(t, Errors{notFound}, FromError(fmt.Errorf("wrapped: %w", notFound)))
+	assert.Equal(t, Errors{notFound}, FromError(&notFound))
+}
+
+func Test_FromError_Errors(t *testing.T) {
+	errs := Errors{{Title: "First."}, {Title: "Second."}}
+
+	assert.Equal(t, errs, FromError(fmt.Errorf("wrapped: %w", errs)))
+}
+
+func Test_FromError_StatusCoder(t *testing.T) {
+	errs := FromError(fmt.Errorf("wrapped: %w", testStatusError{message: "already exists"}))
+
+	assert.Equal(t, Errors{
+		{
+			Title:  "Conflict",
+			Detail: "already exists",
+			Status: Status(http.StatusConflict),
+		},
+	}, errs)
+}
+
+func Test_FromError_ErrorCoder(t *testing.T) {
+	errs := FromError(testCodedError{testStatusError{message: "locked by another user"}})
+
+	assert.Equal(t, Errors{
+		{
+			Title:     "Resource Locked.",
+			Detail:    "locked by another user",
+			Status:    Status(http.StatusConflict),
+			ErrorCode: "E_RESOURCE_LOCKED",
+		},
+	}, errs)
+}
+
+func Test_FromError_Unknown(t *testing.T) {
+	errs := FromError(errors.New("pq: password authentication failed for user admin"))
+
+	assert.Equal(t, Errors{
+		{
+			Title:  "Internal Server Error.",
+			Status: Status(http.StatusInternalServerError),
+		},
+	}, errs)
+}
+
+func Test_Status(t *testing.T) {
+	assert.Equal(t, ErrorStatus(http.StatusNotFound), Status(http.StatusNotFound))
+	assert.Equal(t, "404", Status(http.StatusNotFound).String())
+	assert.Equal(t, "", Status(0).String())
+}
+
+func Test_ErrorStatus_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(Error{Status: http.StatusNotFound, Code: 42})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"status":"404","code":"42"}`, string(data))
+}
+
+func Test_ErrorStatus_UnmarshalJSON_Invalid(t *testing.T) {
+	var status ErrorStatus
+	assert.Error(t, json.Unmarshal([]byte(`"invalid"`), &status))
+}
+
+func Test_Code(t *testing.T) {
+	assert.Equal(t, "42", Code(42))
+	assert.Equal(t, "", Code(0))
+}
+
+func Test_Error_UnmarshalJSON_Strings(t *testing.T) {
+	var err Error
+	assert.NoError(t, json.Unmarshal([]byte(`{"status":"400","code":"E_STANDARD","title":"Bad Request."}`), &err))
+
+	assert.Equal(t, Error{Status: http.StatusBadRequest, ErrorCode: "E_STANDARD", Title: "Bad Request."}, err)
+}
+
+func Test_Error_UnmarshalJSON_Numbers(t *testing.T) {
+	var err Error
+	assert.NoError(t, json.Unmarshal([]byte(`{"status":400,"code":42,"title":"Bad Request."}`), &err))
+
+	assert.Equal(t, Error{Status: http.StatusBadRequest, ErrorCode: "42", Title: "Bad Request."}, err)
+	assert.Equal(t, http.StatusBadRequest, err.StatusCode())
+}
+
+func Test_Error_UnmarshalJSON_Null(t *testing.T) {
+	var err Error
+	assert.NoError(t, json.Unmarshal([]byte(`{"status":null,"detail":"Missing status."}`), &err))
+
+	assert.Equal(t, Error{Detail: "Missing status."}, err)
+}
+
+func Test_Error_UnmarshalJSON_InvalidStatus(t *testing.T) {
+	var err Error
+	assert.Error(t, json.Unmarshal([]byte(`{"status":true}`), &err))
+}
+
+func Test_Error_UnmarshalJSON_RoundTrip(t *testing.T) {
+	original := Error{
+		ID:        "1",
+		Status:    http.StatusConflict,
+		ErrorCode: Code(7),
+		Title:     "Conflict.",
+		Source:    map[string]interface{}{"pointer": "/data/id"},
+	}
+
+	data, marshalErr := json.Marshal(original)
+	assert.NoError(t, marshalErr)
+
+	var err Error
+	assert.NoError(t, json.Unmarshal(data, &err))
+	assert.Equal(t, original, err)
+}
+
+func Test_Error_StatusCode(t *testing.T) {
+	assert.Equal(t, http.StatusNotFound, Error{Status: http.StatusNotFound}.StatusCode())
+	assert.Equal(t, 0, Error{}.StatusCode())
+}
+
+func Test_transformError_Shape(t *testing.T) {
+	err := Error{
+		Status:    Status(http.StatusUnauthorized),
+		ErrorCode: "E_AUTH_EXPIRED",
+		Title:     "Authentication Expired.",
+		Source: ErrorSource{
+			Header: "Authorization",
+		},
+		Links: Links{
+			AboutKey: Link{
+				Href: "/errors/1234",
+			},
+			TypeKey: Link{
+				Href: "https://example.com/errors/auth-expired",
+			},
+		},
+	}
+
+	got, marshalErr := json.Marshal(transformError(err, baseURL))
+
+	assert.Nil(t, marshalErr)
+	assert.JSONEq(t, `{
+		"status": "401",
+		"code": "E_AUTH_EXPIRED",
+		"title": "Authentication Expired.",
+		"source": {"header": "Authorization"},
+		"links": {
+			"about": "`+baseURL+`/errors/1234",
+			"type": "https://example.com/errors/auth-expired"
+		}
+	}`, string(got))
+}
+
+func Test_transformError_DeprecatedCode(t *testing.T) {
+	transformed := transformError(Error{Status: http.StatusBadRequest, Code: 42}, baseURL)
+
+	assert.Equal(t, ErrorStatus(http.StatusBadRequest), transformed.Status)
+	assert.Equal(t, "42", transformed.Code)
+
+	transformed = transformError(Error{Code: 42, ErrorCode: "E_STANDARD"}, baseURL)
+	assert.Equal(t, "E_STANDARD", transformed.Code)
+}
@@ -0,0 +1,101 @@
+package jsonapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// NotFound creates a 404 Not Found Error for a resource that does not exist: https://jsonapi.org/format/#fetching-resources-responses-404
+func NotFound(resourceType string, id string) Error {
+	return Error{
+		Title:  "Resource Not Found.",
+		Detail: fmt.Sprintf("%s resource with id %s was not found", resourceType, id),
+		Status: Status(http.StatusNotFound),
+	}
+}
+
+// Conflict creates a 409 Conflict Error for a member of the request document that conflicts with the endpoint,
+// ex. a type that is not supported or an id that does not match the id of the URL: https://jsonapi.org/format/#crud-updating-responses-409
+func Conflict(pointer string, detail string) Error {
+	return Error{
+		Title:  "Resource Conflict.",
+		Detail: detail,
+		Source: ErrorSource{
+			Pointer: pointer,
+		},
+		Status: Status(http.StatusConflict),
+	}
+}
+
+// Forbidden creates a 403 Forbidden Error for a member of the request document that is not allowed,
+// ex. a client-generated id when those are not supported: https://jsonapi.org/format/#crud-creating-responses-403
+func Forbidden(pointer string, detail string) Error {
+	return Error{
+		Title:  "Forbidden.",
+		Detail: detail,
+		Source: ErrorSource{
+			Pointer: pointer,
+		},
+		Status: Status(http.StatusForbidden),
+	}
+}
+
+// InvalidAttribute creates a 400 Bad Request Error for an attribute of the request document
+func InvalidAttribute(pointer string, detail string) Error {
+	return Error{
+		Title:  "Invalid Attribute.",
+		Detail: detail,
+		Source: ErrorSource{
+			Pointer: pointer,
+		},
+		Status: Status(http.StatusBadRequest),
+	}
+}
+
+// InvalidRelationship creates a 400 Bad Request Error for a relationship of the request document
+func InvalidRelationship(pointer string, detail string) Error {
+	return Error{
+		Title:  "Invalid Relationship.",
+		Detail: detail,
+		Source: ErrorSource{
+			Pointer: pointer,
+		},
+		Status: Status(http.StatusBadRequest),
+	}
+}
+
+// InvalidQueryParameter creates a 400 Bad Request Error for a query parameter of the request
+func InvalidQueryParameter(name string, detail string) Error {
+	return Error{
+		Title:  "Invalid Query Parameter.",
+		Detail: detail,
+		Source: ErrorSource{
+			Parameter: name,
+		},
+		Status: Status(http.StatusBadRequest),
+	}
+}
+
+// UnsupportedMediaType creates a 415 Unsupported Media Type Error for the Content-Type of the request: https://jsonapi.org/format/#content-negotiation-servers
+func UnsupportedMediaType(detail string) Error {
+	return Error{
+		Title:  "Unsupported Media Type.",
+		Detail: detail,
+		Source: ErrorSource{
+			Header: ContentType,
+		},
+		Status: Status(http.StatusUnsupportedMediaType),
+	}
+}
+
+// NotAcceptable creates a 406 Not Acceptable Error for the Accept header of the request: https://jsonapi.org/format/#content-negotiation-servers
+func NotAcceptable(detail string) Error {
+	return Error{
+		Title:  "Not Acceptable.",
+		Detail: detail,
+		Source: ErrorSource{
+			Header: Accept,
+		},
+		Status: Status(http.StatusNotAcceptable),
+	}
+}
@@ -0,0 +1,79 @@
+package jsonapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NotFound(t *testing.T) {
+	err := jsonapi.NotFound("people", "1234")
+
+	assert.Equal(t, http.StatusNotFound, err.StatusCode())
+	assert.Equal(t, "Resource Not Found.", err.Title)
+	assert.Equal(t, "people resource with id 1234 was not found", err.Detail)
+	assert.Nil(t, err.Source)
+}
+
+func Test_Conflict(t *testing.T) {
+	err := jsonapi.Conflict("/data/id", "1234 does not match the id of the URL")
+
+	assert.Equal(t, http.StatusConflict, err.StatusCode())
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/id"}, err.Source)
+}
+
+func Test_Forbidden(t *testing.T) {
+	err := jsonapi.Forbidden("/data/id", "client-generated ids are not supported")
+
+	assert.Equal(t, http.StatusForbidden, err.StatusCode())
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/id"}, err.Source)
+}
+
+func Test_InvalidAttribute(t *testing.T) {
+	err := jsonapi.InvalidAttribute("/data/attributes/age", "age must be a positive number")
+
+	got, marshalErr := json.Marshal(err)
+
+	assert.Nil(t, marshalErr)
+	assert.JSONEq(t, `{
+		"status": "400",
+		"title": "Invalid Attribute.",
+		"detail": "age must be a positive number",
+		"source": {"pointer": "/data/attributes/age"}
+	}`, string(got))
+}
+
+func Test_InvalidRelationship(t *testing.T) {
+	err := jsonapi.InvalidRelationship("/data/relationships/author", "author is a to-one relationship")
+
+	assert.Equal(t, http.StatusBadRequest, err.StatusCode())
+	assert.Equal(t, "Invalid Relationship.", err.Title)
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/relationships/author"}, err.Source)
+}
+
+func Test_InvalidQueryParameter(t *testing.T) {
+	err := jsonapi.InvalidQueryParameter("sort", "age is not a supported sort field")
+
+	assert.Equal(t, http.StatusBadRequest, err.StatusCode())
+	assert.Equal(t, "Invalid Query Parameter.", err.Title)
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "sort"}, err.Source)
+}
+
+func Test_UnsupportedMediaType(t *testing.T) {
+	err := jsonapi.UnsupportedMediaType("text/plain is not a supported media type")
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, err.StatusCode())
+	assert.Equal(t, "Unsupported Media Type.", err.Title)
+	assert.Equal(t, jsonapi.ErrorSource{Header: "Content-Type"}, err.Source)
+}
+
+func Test_NotAcceptable(t *testing.T) {
+	err := jsonapi.NotAcceptable("no acceptable media type")
+
+	assert.Equal(t, http.StatusNotAcceptable, err.StatusCode())
+	assert.Equal(t, "Not Acceptable.", err.Title)
+	assert.Equal(t, jsonapi.ErrorSource{Header: "Accept"}, err.Source)
+}
@@ -0,0 +1,179 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Fieldsets maps resource types to the sparse fieldset requested via the fields[TYPE] query parameters
+type Fieldsets map[string][]string
+
+// Fieldable method for declaring the complete set of fields (attributes and relationships) that can be requested for a resource type
+type Fieldable interface {
+	Fields() []string
+}
+
+// GetFields extracts sparse fieldsets from request query parameters
+func GetFields(request *http.Request) (fieldsets Fieldsets) {
+	for key, values := range request.URL.Query() {
+		resourceType, isFieldset := parseFieldsetKey(key)
+		if !isFieldset || len(values) == 0 {
+			continue
+		}
+
+		if fieldsets == nil {
+			fieldsets = make(Fieldsets)
+		}
+
+		fields := make([]string, 0)
+		for _, field := range strings.Split(values[0], ",") {
+			if len(field) > 0 {
+				fields = append(fields, field)
+			}
+		}
+		fieldsets[resourceType] = fields
+	}
+
+	return
+}
+
+func parseFieldsetKey(key string) (resourceType string, isFieldset bool) {
+	prefix := Fields + "["
+	if !strings.HasPrefix(key, prefix) || !strings.HasSuffix(key, "]") || len(key) <= len(prefix)+1 {
+		return "", false
+	}
+
+	return key[len(prefix) : len(key)-1], true
+}
+
+// FieldsetParameter returns the query parameter name of the sparse fieldset for the provided resource type
+func FieldsetParameter(resourceType string) string {
+	return fmt.Sprintf("%s[%s]", Fields, resourceType)
+}
+
+// HasField checks if the provided field of the resource type should be present in the response.
+// All fields are present when no fieldset was requested for the resource type.
+func (fieldsets Fieldsets) HasField(resourceType string, field string) bool {
+	fields, exists := fieldsets[resourceType]
+	if !exists {
+		return true
+	}
+
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// VerifyFields will return with an array of Errors if any requested field of the resource type is not in the provided, available fields
+func (fieldsets Fieldsets) VerifyFields(resourceType string, fields ...string) (errs Errors) {
+	available := make(map[string]bool)
+	for _, field := range fields {
+		available[field] = true
+	}
+
+	for _, field := range fieldsets[resourceType] {
+		if !available[field] {
+			errs = append(errs, InvalidQueryParameter(FieldsetParameter(resourceType), fmt.Sprintf("%s is not an available field of resource type %s", field, resourceType)))
+		}
+	}
+
+	return
+}
+
+func (fieldsets Fieldsets) verifyNodes(nodes []Node) (errs Errors) {
+	if len(fieldsets) == 0 {
+		return nil
+	}
+
+	verified := make(map[string]bool)
+	for _, node := range nodes {
+		fieldableNode, isFieldable := node.(Fieldable)
+		if !isFieldable || verified[node.Type()] {
+			continue
+		}
+		verified[node.Type()] = true
+
+		errs = append(errs, fieldsets.VerifyFields(node.Type(), fieldableNode.Fields()...)...)
+	}
+
+	return
+}
+
+func (fieldsets Fieldsets) applyData(data interface{}) interface{} {
+	switch node := data.(type) {
+	case internalNode:
+		return fieldsets.applyNode(node)
+	case []internalNode:
+		return fieldsets.applyNodes(node)
+	}
+
+	return data
+}
+
+func (fieldsets Fieldsets) applyNodes(nodes []internalNode) []internalNode {
+	if len(fieldsets) == 0 {
+		return nodes
+	}
+
+	for index, node := range nodes {
+		nodes[index] = fieldsets.applyNode(node)
+	}
+
+	return nodes
+}
+
+func (fieldsets Fieldsets) applyNode(node internalNode) internalNode {
+	if _, exists := fieldsets[node.Type]; !exists {
+		return node
+	}
+
+	node.Attributes = fieldsets.trimAttributes(node.Type, node.Attributes)
+
+	var relationships map[string]internalRelationship
+	for name, relationship := range node.Relationships {
+		if fieldsets.HasField(node.Type, name) {
+			if relationships == nil {
+				relationships = make(map[string]internalRelationship)
+			}
+			relationships[name] = relationship
+		}
+	}
+	node.Relationships = relationships
+
+	return node
+}
+
+func (fieldsets Fieldsets) trimAttributes(resourceType string, attributes interface{}) interface{} {
+	if attributes == nil {
+		return nil
+	}
+
+	marshalled, err := json.Marshal(attributes)
+	if err != nil {
+		return attributes
+	}
+
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(marshalled, &values); err != nil {
+		return attributes
+	}
+
+	trimmed := make(map[string]json.RawMessage)
+	for key, value := range values {
+		if fieldsets.HasField(resourceType, key) {
+			trimmed[key] = value
+		}
+	}
+
+	if len(trimmed) == 0 {
+		return nil
+	}
+
+	return trimmed
+}
@@ -0,0 +1,173 @@
+package jsonapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+type FieldableData struct {
+	SomeData
+}
+
+func (d FieldableData) Fields() []string {
+	return []string{"name", "tranId", "shipTo", "itemName", "relatedData"}
+}
+
+func Test_GetFields(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?fields[articles]=title,body&fields[people]=name&fields[]=bad&other=value", nil)
+
+	fieldsets := jsonapi.GetFields(req)
+
+	assert.Equal(t, jsonapi.Fieldsets{
+		"articles": {"title", "body"},
+		"people":   {"name"},
+	}, fieldsets)
+}
+
+func Test_GetFields_Empty(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?fields[articles]=", nil)
+
+	fieldsets := jsonapi.GetFields(req)
+
+	assert.Equal(t, jsonapi.Fieldsets{"articles": {}}, fieldsets)
+}
+
+func Test_GetFields_Missing(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example", nil)
+
+	fieldsets := jsonapi.GetFields(req)
+
+	assert.Nil(t, fieldsets)
+}
+
+func Test_FieldsetParameter(t *testing.T) {
+	assert.Equal(t, "fields[articles]", jsonapi.FieldsetParameter("articles"))
+}
+
+func Test_HasField(t *testing.T) {
+	fieldsets := jsonapi.Fieldsets{"articles": {"title"}}
+
+	assert.True(t, fieldsets.HasField("articles", "title"))
+	assert.False(t, fieldsets.HasField("articles", "body"))
+	assert.True(t, fieldsets.HasField("people", "name"))
+}
+
+func Test_VerifyFields_OK(t *testing.T) {
+	fieldsets := jsonapi.Fieldsets{"articles": {"title"}}
+
+	errs := fieldsets.VerifyFields("articles", "title", "body")
+
+	assert.False(t, errs.HasErrors())
+}
+
+func Test_VerifyFields_Unknown(t *testing.T) {
+	fieldsets := jsonapi.Fieldsets{"articles": {"title", "unknown"}}
+
+	errs := fieldsets.VerifyFields("articles", "title", "body")
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "fields[articles]"}, errs[0].Source)
+}
+
+func Test_CreateResponse_Fields(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/data/1111?include=relatedData&fields[Data]=name,relatedData&fields[dataRelationship]=", nil)
+
+	response := jsonapi.CreateResponse(req)(jsonapi.Response{
+		Node: SomeData{
+			Name:     "Testing data 1",
+			TranID:   "1111",
+			ShipTo:   "Location 1",
+			ItemName: "Box-o-shingles",
+			DataRelationship: DataRelationship{
+				UUID: "cust1234",
+			},
+		},
+	})
+	response.Links = nil
+
+	got, err := json.Marshal(response)
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"data": {
+			"id": "1111",
+			"type": "Data",
+			"attributes": {
+				"name": "Testing data 1"
+			},
+			"relationships": {
+				"relatedData": {
+					"links": {
+						"resource": "http://example.com/path/to/resource/1111/data"
+					},
+					"data": {
+						"id": "cust1234",
+						"type": "dataRelationship"
+					}
+				}
+			}
+		},
+		"included": [
+			{
+				"id": "cust1234",
+				"type": "dataRelationship"
+			}
+		]
+	}`, string(got))
+}
+
+func Test_CreateCollectionResponse_Fields(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/data?fields[Data]=tranId", nil)
+
+	response := jsonapi.CreateCollectionResponse(req)(jsonapi.CollectionResponse{
+		Nodes: []FieldableData{{SomeData{Name: "Testing data 1", TranID: "2222"}}},
+	})
+	response.Links = nil
+
+	got, err := json.Marshal(response)
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"data": [
+			{
+				"id": "2222",
+				"type": "Data",
+				"attributes": {
+					"tranId": "2222"
+				}
+			}
+		]
+	}`, string(got))
+}
+
+func Test_CreateCollectionResponse_Fields_Unknown(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/data?fields[Data]=tranId,unknown", nil)
+
+	response := jsonapi.CreateCollectionResponse(req)(jsonapi.CollectionResponse{
+		Nodes: []FieldableData{{SomeData{Name: "Testing data 1", TranID: "2222"}}},
+	})
+
+	assert.Nil(t, response.Data)
+	assert.Nil(t, response.Included)
+	assert.Equal(t, 1, len(response.Errors))
+
+	got, err := json.Marshal(response.Errors)
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[
+		{
+			"status": "400",
+			"title": "Invalid Query Parameter.",
+			"detail": "unknown is not an available field of resource type Data",
+			"source": {
+				"parameter": "fields[Data]"
+			}
+		}
+	]`, string(got))
+}
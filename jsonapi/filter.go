@@ -0,0 +1,263 @@
+package jsonapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Standard filter operators used by OperatorFilterStrategy
+const (
+	// FilterEqual matches resources where the field equals one of the values
+	FilterEqual string = "eq"
+	// FilterNotEqual matches resources where the field equals none of the values
+	FilterNotEqual string = "ne"
+	// FilterGreaterThan matches resources where the field is greater than the value
+	FilterGreaterThan string = "gt"
+	// FilterGreaterThanOrEqual matches resources where the field is greater than or equal to the value
+	FilterGreaterThanOrEqual string = "gte"
+	// FilterLessThan matches resources where the field is less than the value
+	FilterLessThan string = "lt"
+	// FilterLessThanOrEqual matches resources where the field is less than or equal to the value
+	FilterLessThanOrEqual string = "lte"
+	// FilterLike matches resources where the field matches the value pattern
+	FilterLike string = "like"
+)
+
+// FilterOperators is the array of all standard filter operators
+var FilterOperators []string = []string{
+	FilterEqual,
+	FilterNotEqual,
+	FilterGreaterThan,
+	FilterGreaterThanOrEqual,
+	FilterLessThan,
+	FilterLessThanOrEqual,
+	FilterLike,
+}
+
+// Filter is a node of the filter query parameter tree, ex. filter[age][gt]=30 is parsed as age -> gt -> [30]
+type Filter struct {
+	// Values are the comma separated values provided at this level of the tree.
+	Values []string
+	// Parameter is the query parameter the Values were provided with, ex. filter[age][gt].
+	Parameter string
+	// Children are the nested bracketed keys below this level of the tree.
+	Children Filters
+}
+
+// Filters maps the bracketed keys of the filter query parameters to nested Filter nodes
+type Filters map[string]*Filter
+
+// FilterCondition is a single condition produced from Filters by a FilterStrategy
+type FilterCondition struct {
+	Field     string
+	Operator  string
+	Values    []string
+	Parameter string
+}
+
+// FilterConditions is an array of FilterCondition items
+type FilterConditions []FilterCondition
+
+// FilterStrategy converts the parsed filter tree into conditions
+type FilterStrategy interface {
+	Conditions(filters Filters) (FilterConditions, Errors)
+}
+
+// GetFilters extracts the filter tree from request query parameters
+func GetFilters(request *http.Request) (filters Filters) {
+	for key, values := range request.URL.Query() {
+		path, isFilter := parseFilterKey(key)
+		if !isFilter {
+			continue
+		}
+
+		if filters == nil {
+			filters = make(Filters)
+		}
+
+		filter := filters.node(path)
+		filter.Parameter = key
+		filter.Values = make([]string, 0)
+		for _, value := range values {
+			for _, v := range strings.Split(value, ",") {
+				if len(v) > 0 {
+					filter.Values = append(filter.Values, v)
+				}
+			}
+		}
+	}
+
+	return
+}
+
+func parseFilterKey(key string) (path []string, isFilter bool) {
+	if !strings.HasPrefix(key, FilterParameter+"[") {
+		return nil, false
+	}
+
+	rest := strings.TrimPrefix(key, FilterParameter)
+	for len(rest) > 0 {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end <= 1 {
+			return nil, false
+		}
+
+		path = append(path, rest[1:end])
+		rest = rest[end+1:]
+	}
+
+	return path, true
+}
+
+func (filters Filters) node(path []string) *Filter {
+	filter, exists := filters[path[0]]
+	if !exists {
+		filter = new(Filter)
+		filters[path[0]] = filter
+	}
+
+	if len(path) == 1 {
+		return filter
+	}
+
+	if filter.Children == nil {
+		filter.Children = make(Filters)
+	}
+
+	return filter.Children.node(path[1:])
+}
+
+// Get retrieves the Filter node at the provided path of keys, ex. Get("age", "gt")
+func (filters Filters) Get(path ...string) (*Filter, bool) {
+	if len(path) == 0 {
+		return nil, false
+	}
+
+	filter, exists := filters[path[0]]
+	if !exists || len(path) == 1 {
+		return filter, exists
+	}
+
+	return filter.Children.Get(path[1:]...)
+}
+
+// Walk visits every Filter node that has values in sorted key order along with its path of keys
+func (filters Filters) Walk(visit func(path []string, filter *Filter)) {
+	filters.walk(nil, visit)
+}
+
+func (filters Filters) walk(parent []string, visit func(path []string, filter *Filter)) {
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		path := append(append(make([]string, 0, len(parent)+1), parent...), key)
+		filter := filters[key]
+
+		if filter.Values != nil {
+			visit(path, filter)
+		}
+
+		filter.Children.walk(path, visit)
+	}
+}
+
+// Conditions converts the filter tree into conditions using the provided FilterStrategy
+func (filters Filters) Conditions(strategy FilterStrategy) (FilterConditions, Errors) {
+	return strategy.Conditions(filters)
+}
+
+// EqualityFilterStrategy treats every filter[FIELD]=VALUES as an equality condition.
+// Nested keys are joined into a dotted field path, ex. filter[author][name] is the author.name field.
+type EqualityFilterStrategy struct{}
+
+// Conditions converts the filter tree into equality conditions
+func (strategy EqualityFilterStrategy) Conditions(filters Filters) (conditions FilterConditions, errs Errors) {
+	filters.Walk(func(path []string, filter *Filter) {
+		conditions = append(conditions, FilterCondition{
+			Field:     strings.Join(path, "."),
+			Operator:  FilterEqual,
+			Values:    filter.Values,
+			Parameter: filter.Parameter,
+		})
+	})
+
+	return
+}
+
+// OperatorFilterStrategy treats the last nested key as an operator when it is one of the provided Operators, ex. filter[age][gt]=30.
+// Filters without an operator use the DefaultOperator, ex. filter[name]=a,b. Remaining keys are joined into a dotted field path.
+type OperatorFilterStrategy struct {
+	// Operators is the vocabulary of supported operators, defaults to FilterOperators.
+	Operators []string
+	// DefaultOperator is applied when no operator is provided, defaults to FilterEqual.
+	DefaultOperator string
+}
+
+// Conditions converts the filter tree into operator conditions
+func (strategy OperatorFilterStrategy) Conditions(filters Filters) (conditions FilterConditions, errs Errors) {
+	operators := strategy.Operators
+	if operators == nil {
+		operators = FilterOperators
+	}
+
+	defaultOperator := strategy.DefaultOperator
+	if len(defaultOperator) == 0 {
+		defaultOperator = FilterEqual
+	}
+
+	isOperator := make(map[string]bool)
+	for _, operator := range operators {
+		isOperator[operator] = true
+	}
+
+	filters.Walk(func(path []string, filter *Filter) {
+		condition := FilterCondition{
+			Field:     strings.Join(path, "."),
+			Operator:  defaultOperator,
+			Values:    filter.Values,
+			Parameter: filter.Parameter,
+		}
+
+		if last := len(path) - 1; last > 0 && isOperator[path[last]] {
+			condition.Field = strings.Join(path[:last], ".")
+			condition.Operator = path[last]
+		}
+
+		conditions = append(conditions, condition)
+	})
+
+	return
+}
+
+// HasField will check the provided conditions for the requested field
+func (conditions FilterConditions) HasField(field string) bool {
+	for _, condition := range conditions {
+		if condition.Field == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// VerifyFields will return with an array of Errors if any condition filters on a field that is not in the provided, filterable fields
+func (conditions FilterConditions) VerifyFields(fields ...string) (errs Errors) {
+	filterable := make(map[string]bool)
+	for _, field := range fields {
+		filterable[field] = true
+	}
+
+	for _, condition := range conditions {
+		if !filterable[condition.Field] {
+			errs = append(errs, InvalidQueryParameter(condition.Parameter, fmt.Sprintf("%s is not a filterable field", condition.Field)))
+		}
+	}
+
+	return
+}
@@ -0,0 +1,121 @@
+package jsonapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetFilters(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?filter[name]=a,b&filter[age][gt]=30&filter[age][lt]=40&filter[author][name]=John", nil)
+
+	filters := jsonapi.GetFilters(req)
+
+	assert.Equal(t, 3, len(filters))
+
+	name, exists := filters.Get("name")
+	assert.True(t, exists)
+	assert.Equal(t, []string{"a", "b"}, name.Values)
+	assert.Equal(t, "filter[name]", name.Parameter)
+
+	age, exists := filters.Get("age")
+	assert.True(t, exists)
+	assert.Nil(t, age.Values)
+	assert.Equal(t, 2, len(age.Children))
+
+	gt, exists := filters.Get("age", "gt")
+	assert.True(t, exists)
+	assert.Equal(t, []string{"30"}, gt.Values)
+	assert.Equal(t, "filter[age][gt]", gt.Parameter)
+
+	_, exists = filters.Get("age", "gte")
+	assert.False(t, exists)
+}
+
+func Test_GetFilters_Malformed(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?filter=a&filter[]=b&filter[name=c&filter[name]x=d&filters[name]=e", nil)
+
+	filters := jsonapi.GetFilters(req)
+
+	assert.Nil(t, filters)
+}
+
+func Test_GetFilters_Missing(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example", nil)
+
+	filters := jsonapi.GetFilters(req)
+
+	assert.Nil(t, filters)
+}
+
+func Test_Filters_Walk(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?filter[b]=1&filter[a][y]=2&filter[a][x]=3&filter[a]=4", nil)
+
+	var paths [][]string
+	jsonapi.GetFilters(req).Walk(func(path []string, filter *jsonapi.Filter) {
+		paths = append(paths, path)
+	})
+
+	assert.Equal(t, [][]string{{"a"}, {"a", "x"}, {"a", "y"}, {"b"}}, paths)
+}
+
+func Test_EqualityFilterStrategy(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?filter[name]=a,b&filter[author][name]=John", nil)
+
+	conditions, errs := jsonapi.GetFilters(req).Conditions(jsonapi.EqualityFilterStrategy{})
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.FilterConditions{
+		{Field: "author.name", Operator: jsonapi.FilterEqual, Values: []string{"John"}, Parameter: "filter[author][name]"},
+		{Field: "name", Operator: jsonapi.FilterEqual, Values: []string{"a", "b"}, Parameter: "filter[name]"},
+	}, conditions)
+}
+
+func Test_OperatorFilterStrategy(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?filter[name]=a,b&filter[age][gt]=30&filter[author][name][ne]=John", nil)
+
+	conditions, errs := jsonapi.GetFilters(req).Conditions(jsonapi.OperatorFilterStrategy{})
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.FilterConditions{
+		{Field: "age", Operator: jsonapi.FilterGreaterThan, Values: []string{"30"}, Parameter: "filter[age][gt]"},
+		{Field: "author.name", Operator: jsonapi.FilterNotEqual, Values: []string{"John"}, Parameter: "filter[author][name][ne]"},
+		{Field: "name", Operator: jsonapi.FilterEqual, Values: []string{"a", "b"}, Parameter: "filter[name]"},
+	}, conditions)
+}
+
+func Test_OperatorFilterStrategy_CustomOperators(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?filter[age][gt]=30&filter[name]=John", nil)
+
+	conditions, errs := jsonapi.GetFilters(req).Conditions(jsonapi.OperatorFilterStrategy{
+		Operators:       []string{"contains"},
+		DefaultOperator: "contains",
+	})
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.FilterConditions{
+		{Field: "age.gt", Operator: "contains", Values: []string{"30"}, Parameter: "filter[age][gt]"},
+		{Field: "name", Operator: "contains", Values: []string{"John"}, Parameter: "filter[name]"},
+	}, conditions)
+}
+
+func Test_FilterConditions_HasField(t *testing.T) {
+	conditions := jsonapi.FilterConditions{{Field: "name"}}
+
+	assert.True(t, conditions.HasField("name"))
+	assert.False(t, conditions.HasField("age"))
+}
+
+func Test_FilterConditions_VerifyFields(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?filter[name]=a&filter[age][gt]=30&filter[secret]=x", nil)
+	conditions, _ := jsonapi.GetFilters(req).Conditions(jsonapi.OperatorFilterStrategy{})
+
+	errs := conditions.VerifyFields("name", "age")
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "filter[secret]"}, errs[0].Source)
+}
@@ -2,12 +2,17 @@ package jsonapi
 
 import (
 	"net/http"
+	"sort"
 	"strings"
 )
 
-// Included string array representing the included query parameter resources
+// Included string array representing the included query parameter resources.
+// Each member may be a dot-separated path of relationship names, ex. author.comments
 type Included []string
 
+// IncludeTree is the nested representation of dot-separated include paths, ex. author.comments is author -> comments
+type IncludeTree map[string]IncludeTree
+
 // GetIncluded extracts included object names from request query parameters
 func GetIncluded(request *http.Request) (included Included) {
 	includedQuery := request.URL.Query().Get(Include)
@@ -20,10 +25,11 @@ func GetIncluded(request *http.Request) (included Included) {
 	return
 }
 
-// HasResource will check the provided included array for the requested resource name
+// HasResource will check the provided included array for the requested resource name.
+// Intermediate resources of a requested path are also considered requested, ex. author is requested by author.comments
 func (included Included) HasResource(resource string) bool {
 	for _, include := range included {
-		if include == resource {
+		if include == resource || strings.HasPrefix(include, resource+".") {
 			return true
 		}
 	}
@@ -31,6 +37,65 @@ func (included Included) HasResource(resource string) bool {
 	return false
 }
 
+// Tree parses the included paths into an IncludeTree
+func (included Included) Tree() IncludeTree {
+	tree := make(IncludeTree)
+
+	for _, include := range included {
+		branch := tree
+		for _, name := range strings.Split(include, ".") {
+			if len(name) == 0 {
+				break
+			}
+
+			next, exists := branch[name]
+			if !exists {
+				next = make(IncludeTree)
+				branch[name] = next
+			}
+			branch = next
+		}
+	}
+
+	return tree
+}
+
+// Has will check the tree for the provided dot-separated path
+func (tree IncludeTree) Has(path string) bool {
+	branch := tree
+	for _, name := range strings.Split(path, ".") {
+		next, exists := branch[name]
+		if !exists {
+			return false
+		}
+		branch = next
+	}
+
+	return true
+}
+
+// Paths returns every dot-separated path of the tree in sorted order, ex. author, author.comments
+func (tree IncludeTree) Paths() (paths []string) {
+	for _, name := range tree.names() {
+		paths = append(paths, name)
+		for _, path := range tree[name].Paths() {
+			paths = append(paths, name+"."+path)
+		}
+	}
+
+	return
+}
+
+func (tree IncludeTree) names() []string {
+	names := make([]string, 0, len(tree))
+	for name := range tree {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
 // VerifyResources verifies that all requested included members exist in available resources
 func (included Included) VerifyResources(resources ...string) error {
 	if len(included) > len(resources) {
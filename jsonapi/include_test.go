@@ -1,6 +1,7 @@
 package jsonapi_test
 
 import (
+	"encoding/json"
 	"net/http/httptest"
 	"testing"
 
@@ -75,3 +76,197 @@ func Test_VerifyResources_ResourceNotAvailable(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, jsonapi.ErrResourceNotAvailable, err)
 }
+
+func Test_HasResource_NestedPath(t *testing.T) {
+	included := jsonapi.Included{"author.comments", "tags"}
+
+	assert.Equal(t, true, included.HasResource("author"))
+	assert.Equal(t, true, included.HasResource("author.comments"))
+	assert.Equal(t, false, included.HasResource("comments"))
+	assert.Equal(t, false, included.HasResource("auth"))
+}
+
+func Test_Tree(t *testing.T) {
+	included := jsonapi.Included{"author.comments.author", "author", "tags", "comments."}
+
+	tree := included.Tree()
+
+	assert.Equal(t, jsonapi.IncludeTree{
+		"author": {
+			"comments": {
+				"author": {},
+			},
+		},
+		"tags":     {},
+		"comments": {},
+	}, tree)
+}
+
+func Test_IncludeTree_Has(t *testing.T) {
+	tree := jsonapi.Included{"author.comments.author"}.Tree()
+
+	assert.Equal(t, true, tree.Has("author"))
+	assert.Equal(t, true, tree.Has("author.comments"))
+	assert.Equal(t, true, tree.Has("author.comments.author"))
+	assert.Equal(t, false, tree.Has("comments"))
+	assert.Equal(t, false, tree.Has("author.tags"))
+}
+
+func Test_IncludeTree_Paths(t *testing.T) {
+	tree := jsonapi.Included{"tags", "author.comments.author"}.Tree()
+
+	assert.Equal(t, []string{"author", "author.comments", "author.comments.author", "tags"}, tree.Paths())
+}
+
+type IncludeCompany struct {
+	CompanyID string `jsonapi:"primary,companies"`
+	Name      string `jsonapi:"attr,name"`
+}
+
+type IncludePerson struct {
+	PersonID string          `jsonapi:"primary,people"`
+	Name     string          `jsonapi:"attr,name"`
+	Company  *IncludeCompany `jsonapi:"relation,company,omitempty"`
+}
+
+type IncludeComment struct {
+	CommentID string         `jsonapi:"primary,comments"`
+	Body      string         `jsonapi:"attr,body"`
+	Author    *IncludePerson `jsonapi:"relation,author,omitempty"`
+}
+
+type IncludeArticle struct {
+	ArticleID string           `jsonapi:"primary,articles"`
+	Title     string           `jsonapi:"attr,title"`
+	Author    *IncludePerson   `jsonapi:"relation,author,omitempty"`
+	Comments  []IncludeComment `jsonapi:"relation,comments,omitempty"`
+}
+
+var includeArticle = IncludeArticle{
+	ArticleID: "1",
+	Title:     "JSON:API",
+	Author: &IncludePerson{
+		PersonID: "9",
+		Name:     "Dan",
+		Company:  &IncludeCompany{CompanyID: "3", Name: "Example"},
+	},
+	Comments: []IncludeComment{
+		{CommentID: "5", Body: "First!", Author: &IncludePerson{PersonID: "2", Name: "Sally"}},
+	},
+}
+
+func Test_TransformResponse_NestedInclude(t *testing.T) {
+	response := jsonapi.TransformResponse(jsonapi.Response{
+		Node:    includeArticle,
+		Include: jsonapi.Included{"author.company", "comments.author"},
+	}, "https://example.com")
+
+	got, err := json.Marshal(response.Included)
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[
+		{
+			"id": "9",
+			"type": "people",
+			"attributes": {"name": "Dan"},
+			"relationships": {
+				"company": {"data": {"id": "3", "type": "companies"}}
+			}
+		},
+		{
+			"id": "3",
+			"type": "companies",
+			"attributes": {"name": "Example"}
+		},
+		{
+			"id": "5",
+			"type": "comments",
+			"attributes": {"body": "First!"},
+			"relationships": {
+				"author": {"data": {"id": "2", "type": "people"}}
+			}
+		},
+		{
+			"id": "2",
+			"type": "people",
+			"attributes": {"name": "Sally"}
+		}
+	]`, string(got))
+}
+
+func Test_TransformCollectionResponse_NestedInclude_Partial(t *testing.T) {
+	response := jsonapi.TransformCollectionResponse(jsonapi.CollectionResponse{
+		Nodes:   []IncludeArticle{includeArticle},
+		Include: jsonapi.Included{"comments"},
+	}, "https://example.com")
+
+	assert.Equal(t, 1, len(response.Included))
+
+	got, err := json.Marshal(response.Included)
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[
+		{
+			"id": "5",
+			"type": "comments",
+			"attributes": {"body": "First!"},
+			"relationships": {
+				"author": {"data": {"id": "2", "type": "people"}}
+			}
+		}
+	]`, string(got))
+}
+
+func Test_TransformResponse_EmptyInclude(t *testing.T) {
+	response := jsonapi.TransformResponse(jsonapi.Response{
+		Node:    includeArticle,
+		Include: jsonapi.Included{},
+	}, "https://example.com")
+
+	assert.Nil(t, response.Included)
+}
+
+func Test_TransformCollectionResponse_DuplicateIncluded(t *testing.T) {
+	author := &IncludePerson{PersonID: "9", Name: "Dan"}
+
+	response := jsonapi.TransformCollectionResponse(jsonapi.CollectionResponse{
+		Nodes: []IncludeArticle{
+			{ArticleID: "1", Author: author, Comments: []IncludeComment{{CommentID: "5", Author: author}}},
+			{ArticleID: "2", Author: author},
+		},
+		Include: jsonapi.Included{"author", "comments.author"},
+	}, "https://example.com")
+
+	got, err := json.Marshal(response.Included)
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[
+		{
+			"id": "9",
+			"type": "people",
+			"attributes": {"name": "Dan"}
+		},
+		{
+			"id": "5",
+			"type": "comments",
+			"attributes": {"body": ""},
+			"relationships": {
+				"author": {"data": {"id": "9", "type": "people"}}
+			}
+		}
+	]`, string(got))
+}
+
+func Test_TransformResponse_PrimaryNotIncluded(t *testing.T) {
+	person := IncludePerson{PersonID: "9", Name: "Dan"}
+
+	response := jsonapi.TransformResponse(jsonapi.Response{
+		Node: IncludeComment{CommentID: "5", Author: &person},
+	}, "https://example.com")
+	assert.Equal(t, 1, len(response.Included))
+
+	response = jsonapi.TransformCollectionResponse(jsonapi.CollectionResponse{
+		Nodes: []interface{}{IncludeComment{CommentID: "5", Author: &person}, person},
+	}, "https://example.com")
+	assert.Equal(t, 0, len(response.Included))
+}
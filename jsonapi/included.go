@@ -1,39 +1,141 @@
 package jsonapi
 
-func transformIncluded(includedNode []Node, node interface{}, baseURL string) (included []internalNode) {
+import (
+	"fmt"
+)
+
+// collectIncluded walks the relationships of the provided nodes, and recursively of their related nodes, along the include tree
+func collectIncluded(nodes []Node, tree IncludeTree) (included []Node) {
+	for _, node := range nodes {
+		for _, name := range tree.names() {
+			for _, related := range relatedNodes(node, name) {
+				included = append(included, related)
+				included = append(included, collectIncluded([]Node{related}, tree[name])...)
+			}
+		}
+	}
+
+	return
+}
+
+// verifyIncluded will return with an array of Errors for every include path that is not a known relationship of the nodes it is walked from.
+// Paths below relationships without any related nodes cannot be verified and are considered known.
+func verifyIncluded(nodes []Node, tree IncludeTree, parentPath string) (errs Errors) {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	for _, name := range tree.names() {
+		path := name
+		if len(parentPath) > 0 {
+			path = parentPath + "." + name
+		}
+
+		isKnown := false
+		var related []Node
+		for _, node := range nodes {
+			if hasRelationship(node, name) {
+				isKnown = true
+				related = append(related, relatedNodes(node, name)...)
+			}
+		}
+
+		if !isKnown {
+			errs = append(errs, InvalidQueryParameter(Include, fmt.Sprintf("%s is not a known relationship path", path)))
+			continue
+		}
+
+		errs = append(errs, verifyIncluded(related, tree[name], path)...)
+	}
+
+	return
+}
+
+func hasRelationship(node Node, name string) bool {
+	if tagged, isTagged := node.(taggedNode); isTagged {
+		return tagged.hasRelationship(name)
+	}
+
+	if relationshipNode, isRelationshipable := node.(Relationshipable); isRelationshipable {
+		_, exists := relationshipNode.Relationships()[name]
+		return exists
+	}
+
+	return false
+}
+
+func relatedNodes(node Node, name string) []Node {
+	relationshipNode, isRelationshipable := node.(Relationshipable)
+	if !isRelationshipable {
+		return nil
+	}
+
+	relationship, exists := relationshipNode.Relationships()[name]
+	if !exists {
+		return nil
+	}
+
+	_, related := transformRelationshipData(relationship)
+	return related
+}
+
+func transformIncluded(includedNodes []Node, node interface{}, baseURL string) (included []internalNode) {
 	// included cannot exist if node does not exist: https://jsonapi.org/format/#document-top-level
 	if node == nil {
 		return
 	}
 
-	for _, node := range includedNode {
-		included = append(included, transformIncludedNode(node, baseURL))
+	for _, includedNode := range dedupeIncluded(includedNodes, node) {
+		included = append(included, transformIncludedNode(includedNode, baseURL))
 	}
 
 	return
 }
 
-func transformIncludedNode(node Node, baseURL string) internalNode {
-	var links LinkMap
-	if linkableNode, isLinkable := node.(Linkable); isLinkable {
-		links = TransformLinks(linkableNode.Links(), baseURL)
-	}
+// resourceKey uniquely identifies a resource within a compound document
+type resourceKey struct {
+	id           string
+	resourceType string
+}
 
-	var meta interface{}
-	if metaNode, isMetable := node.(Metable); isMetable {
-		meta = metaNode.Meta()
-	}
+// dedupeIncluded collapses included nodes on type and id, and drops any node already present in primary data: https://jsonapi.org/format/#document-compound-documents
+// The order of first appearance is kept.
+func dedupeIncluded(includedNodes []Node, data interface{}) []Node {
+	seen := make(map[resourceKey]bool)
 
-	var attributes interface{} = node
-	if attributeNode, isAttributeable := node.(Attributeable); isAttributeable {
-		attributes = attributeNode.Attributes()
+	switch primary := data.(type) {
+	case internalNode:
+		seen[resourceKey{primary.ID, primary.Type}] = true
+	case []internalNode:
+		for _, node := range primary {
+			seen[resourceKey{node.ID, node.Type}] = true
+		}
 	}
 
-	return internalNode{
-		ID:         node.ID(),
-		Type:       node.Type(),
-		Attributes: attributes,
-		Links:      links,
-		Meta:       meta,
+	deduped := make([]Node, 0, len(includedNodes))
+	for _, node := range includedNodes {
+		key := resourceKey{node.ID(), node.Type()}
+
+		// resources without an id cannot be identified as duplicates
+		if len(key.id) == 0 {
+			deduped = append(deduped, node)
+			continue
+		}
+
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		deduped = append(deduped, node)
 	}
+
+	return deduped
+}
+
+// transformIncludedNode renders an included node through the same path as primary data, with relationships limited to resource linkage.
+// The related nodes of an included node are only added to the compound document when reached by the include tree.
+func transformIncludedNode(node Node, baseURL string) internalNode {
+	internalNode, _ := transformNode(node, baseURL)
+	return internalNode
 }
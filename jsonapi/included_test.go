@@ -43,3 +43,50 @@ func Test_transformIncludedNode(t *testing.T) {
 	assert.NotNil(t, include.Links[SelfKey])
 	assert.Equal(t, baseURL+testObject.Links()[SelfKey].Href, include.Links[SelfKey])
 }
+
+func Test_transformIncludedNode_Relationships(t *testing.T) {
+	include := transformIncludedNode(testObject, baseURL)
+
+	assert.Equal(t, 2, len(include.Relationships))
+	assert.Equal(t, internalResourceIdentifier{ID: testObject.SingleTest.ID(), Type: testObject.SingleTest.Type()}, include.Relationships["test"].Data)
+	assert.Equal(t, 1, len(include.Relationships["tests"].Data.([]internalResourceIdentifier)))
+}
+
+func Test_collectIncluded(t *testing.T) {
+	included := collectIncluded([]Node{testObject}, Included{"tests", "unknown"}.Tree())
+
+	assert.Equal(t, 1, len(included))
+	assert.Equal(t, testObject.TestData[0], included[0])
+}
+
+func Test_verifyIncluded(t *testing.T) {
+	errs := verifyIncluded([]Node{testObject}, Included{"tests", "test.unknown", "unknown"}.Tree(), "")
+
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, ErrorSource{Parameter: Include}, errs[0].Source)
+	assert.Equal(t, "test.unknown is not a known relationship path", errs[0].Detail)
+	assert.Equal(t, "unknown is not a known relationship path", errs[1].Detail)
+}
+
+func Test_verifyIncluded_NoNodes(t *testing.T) {
+	errs := verifyIncluded(nil, Included{"unknown"}.Tree(), "")
+
+	assert.Nil(t, errs)
+}
+
+func Test_dedupeIncluded(t *testing.T) {
+	first := testStruct{TestID: "1"}
+	second := testStruct{TestID: "2"}
+	noID := testStruct{}
+
+	included := dedupeIncluded([]Node{first, second, first, second, testObject, noID, noID}, internalNode{ID: testObject.ID(), Type: testObject.Type()})
+
+	assert.Equal(t, []Node{first, second, noID, noID}, included)
+}
+
+func Test_transformIncluded_Duplicates(t *testing.T) {
+	included := transformIncluded([]Node{testObject.SingleTest, testObject, testObject.SingleTest}, []internalNode{{ID: testObject.ID(), Type: testObject.Type()}}, baseURL)
+
+	assert.Equal(t, 1, len(included))
+	assert.Equal(t, testObject.SingleTest.ID(), included[0].ID)
+}
@@ -11,22 +11,31 @@ package jsonapi
 
 // Response is the standard JSONAPI Response struct
 type Response struct {
-	Node   Node
-	Errors Errors
-	Links  Links
-	Meta   interface{}
+	Node       interface{} // Node | jsonapi tagged struct
+	Errors     Errors
+	Links      Links
+	Meta       interface{}
+	Fields     Fieldsets
+	Include    Included // nil will include every directly related resource
+	IncludeAll bool     // includes every directly related resource regardless of Include
+	JSONAPI    *JSONAPI // nil will use DefaultJSONAPI
 }
 
 // CollectionResponse is the standard JSONAPI collection Response struct
 type CollectionResponse struct {
-	Nodes  interface{} // Node | []Node
-	Errors Errors
-	Links  Links
-	Meta   interface{}
+	Nodes      interface{} // Node | []Node | jsonapi tagged struct(s)
+	Errors     Errors
+	Links      Links
+	Meta       interface{}
+	Fields     Fieldsets
+	Include    Included // nil will include every directly related resource
+	IncludeAll bool     // includes every directly related resource regardless of Include
+	JSONAPI    *JSONAPI // nil will use DefaultJSONAPI
 }
 
 // TransformedResponse is the resulting Data struct after transforming via TransformResponse/TransformCollectionResponse
 type TransformedResponse struct {
+	JSONAPI  *JSONAPI        `json:"jsonapi,omitempty"`
 	Data     interface{}     `json:"data,omitempty"` // Node | []Node
 	Errors   []internalError `json:"errors,omitempty"`
 	Included []internalNode  `json:"included,omitempty"`
@@ -36,26 +45,36 @@ type TransformedResponse struct {
 
 // TransformResponse transforms provided parameters into standardized JSONAPI format
 func TransformResponse(r Response, baseURL string) TransformedResponse {
-	data, included := transformResponseNode(r, baseURL)
-
-	return TransformedResponse{
-		Data:     data,
-		Included: transformIncluded(included, data, baseURL),
-		Errors:   transformErrors(r.Errors, baseURL),
-		Links:    TransformLinks(r.Links, baseURL),
-		Meta:     r.Meta,
-	}
+	data, included, errs := transformResponseNode(r, baseURL)
+
+	return document{
+		primary:    collectNodes(r.Node),
+		data:       data,
+		included:   included,
+		errors:     append(r.Errors, errs...),
+		links:      r.Links,
+		meta:       r.Meta,
+		fields:     r.Fields,
+		include:    r.Include,
+		includeAll: r.IncludeAll,
+		jsonapi:    r.JSONAPI,
+	}.transform(baseURL)
 }
 
 // TransformCollectionResponse transforms provided parameters into standardized collection JSONAPI format
 func TransformCollectionResponse(r CollectionResponse, baseURL string) TransformedResponse {
-	nodes, included := transformCollectionResponseNodes(r, baseURL)
-
-	return TransformedResponse{
-		Data:     nodes,
-		Included: transformIncluded(included, nodes, baseURL),
-		Errors:   transformErrors(r.Errors, baseURL),
-		Links:    TransformLinks(r.Links, baseURL),
-		Meta:     r.Meta,
-	}
+	nodes, included, errs := transformCollectionResponseNodes(r, baseURL)
+
+	return document{
+		primary:    collectNodes(r.Nodes),
+		data:       nodes,
+		included:   included,
+		errors:     append(r.Errors, errs...),
+		links:      r.Links,
+		meta:       r.Meta,
+		fields:     r.Fields,
+		include:    r.Include,
+		includeAll: r.IncludeAll,
+		jsonapi:    r.JSONAPI,
+	}.transform(baseURL)
 }
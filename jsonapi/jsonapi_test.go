@@ -514,7 +514,7 @@ func TestTransformCollectionResponse(t *testing.T) {
 	"errors": [
 		{
 			"id": "12345",
-			"status": 500
+			"status": "500"
 		}
 	]
 }`,
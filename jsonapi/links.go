@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 )
 
@@ -127,13 +128,40 @@ func appendQueryParams(link Link) Link {
 		}
 		q.Set(key, fmt.Sprintf("%v", value))
 	}
-	u.RawQuery, _ = url.QueryUnescape(q.Encode())
+	u.RawQuery = encodeQuery(q)
 
 	link.Href = u.String()
 
 	return link
 }
 
+// bracketReplacer keeps the brackets of query parameter families readable, ex. page[size] instead of page%5Bsize%5D
+var bracketReplacer = strings.NewReplacer("%5B", "[", "%5D", "]")
+
+// encodeQuery encodes the query values sorted by key like url.Values.Encode, escaping every value while keeping the brackets of keys readable
+func encodeQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var query strings.Builder
+	for _, key := range keys {
+		escapedKey := bracketReplacer.Replace(url.QueryEscape(key))
+		for _, value := range values[key] {
+			if query.Len() > 0 {
+				query.WriteByte('&')
+			}
+			query.WriteString(escapedKey)
+			query.WriteByte('=')
+			query.WriteString(url.QueryEscape(value))
+		}
+	}
+
+	return query.String()
+}
+
 func stringOrLinkObject(jsonLink Link) (link interface{}) {
 	if jsonLink.Meta == nil || len(jsonLink.Meta) == 0 {
 		return jsonLink.Href
@@ -167,7 +195,8 @@ func PageSizeNextLinks(request *http.Request) func(link Link, moreResultsAvailab
 	}
 }
 
-// PageSizeNextLink creates a Link object for next pagination step (using PageNumber/PageSize)
+// PageSizeNextLink creates a Link object for next pagination step (using PageNumber/PageSize).
+// Page numbers are assumed to be 0-based, use PageNumberPaginator for 1-based page numbers and the remaining pagination links.
 func PageSizeNextLink(request *http.Request) func(link Link) (nextLink Link) {
 	return func(link Link) Link {
 
@@ -184,7 +213,8 @@ func PageSizeNextLink(request *http.Request) func(link Link) (nextLink Link) {
 	}
 }
 
-// PageLimitNextLinks creates a Links map for next pagination step (using PageOffset/PageLimit)
+// PageLimitNextLinks creates a Links map for next pagination step (using PageOffset/PageLimit).
+// Use OffsetPaginator to validate the requested page and for the remaining pagination links.
 func PageLimitNextLinks(request *http.Request) func(link Link, moreResultsAvailable bool, numResults int) Links {
 	return func(link Link, moreResultsAvailable bool, numResults int) Links {
 		links := make(Links)
@@ -274,5 +304,5 @@ func CursorPrevLink(href string, params Params, size int, before *string) (link
 		Href:    href,
 		Params:  params,
 		Queries: queries,
-	}, "prev", true
+	}, PreviousKey, true
 }
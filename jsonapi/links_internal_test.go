@@ -1,6 +1,7 @@
 package jsonapi
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -195,3 +196,13 @@ func Test_stringOrLinkObject_WithMeta(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, link, transformedLink)
 }
+
+func Test_encodeQuery(t *testing.T) {
+	query := encodeQuery(url.Values{
+		"page[size]":       {"20"},
+		"fields[articles]": {"title,body"},
+		"filter[name]":     {"a b&c"},
+	})
+
+	assert.Equal(t, "fields[articles]=title%2Cbody&filter[name]=a+b%26c&page[size]=20", query)
+}
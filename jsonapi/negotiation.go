@@ -0,0 +1,168 @@
+package jsonapi
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// MediaTypeParams holds the ext and profile parameters of the JSON:API media type: https://jsonapi.org/format/#media-type-parameter-rules
+type MediaTypeParams struct {
+	Ext     []string // extension URIs
+	Profile []string // profile URIs
+}
+
+// ParseMediaType parses a single Content-Type or Accept value.
+// ErrNotMediaType is returned for any media type other than the JSON:API media type,
+// and ErrUnsupportedMediaTypeParameter for a JSON:API media type modified by any parameter other than ext or profile.
+// The quality value of an Accept value is ignored, NegotiateAccept excludes the values with a quality of 0.
+func ParseMediaType(value string) (params MediaTypeParams, err error) {
+	mediaType, parameters, err := mime.ParseMediaType(value)
+	if err != nil {
+		if strings.EqualFold(strings.TrimSpace(strings.Split(value, ";")[0]), MediaType) {
+			return params, ErrUnsupportedMediaTypeParameter
+		}
+		return params, ErrNotMediaType
+	}
+
+	if mediaType != MediaType {
+		return params, ErrNotMediaType
+	}
+
+	for name, value := range parameters {
+		switch name {
+		case ExtParameter:
+			params.Ext = strings.Fields(value)
+		case ProfileParameter:
+			params.Profile = strings.Fields(value)
+		case qualityParameter:
+		default:
+			return MediaTypeParams{}, ErrUnsupportedMediaTypeParameter
+		}
+	}
+
+	return
+}
+
+// String returns the JSON:API media type with the ext and profile parameters, ex. application/vnd.api+json; ext="https://example.com/ext"
+func (params MediaTypeParams) String() string {
+	mediaType := MediaType
+	if len(params.Ext) > 0 {
+		mediaType += fmt.Sprintf(`; %s="%s"`, ExtParameter, strings.Join(params.Ext, " "))
+	}
+	if len(params.Profile) > 0 {
+		mediaType += fmt.Sprintf(`; %s="%s"`, ProfileParameter, strings.Join(params.Profile, " "))
+	}
+
+	return mediaType
+}
+
+// supportsExt checks if every provided extension is supported
+func (params MediaTypeParams) supportsExt(ext []string) bool {
+Outer:
+	for _, e := range ext {
+		for _, supported := range params.Ext {
+			if e == supported {
+				continue Outer
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// CheckContentType will return with an array of Errors if the request Content-Type is the JSON:API media type
+// modified by unsupported parameters or extensions. Any other Content-Type is left to the handler.
+func CheckContentType(request *http.Request) func(supported MediaTypeParams) Errors {
+	return func(supported MediaTypeParams) (errs Errors) {
+		contentType := request.Header.Get(ContentType)
+		if len(contentType) == 0 {
+			return nil
+		}
+
+		params, err := ParseMediaType(contentType)
+		if err == ErrNotMediaType {
+			return nil
+		}
+
+		if err != nil || !supported.supportsExt(params.Ext) {
+			errs = append(errs, UnsupportedMediaType(fmt.Sprintf("%s is not a supported media type", contentType)))
+		}
+
+		return
+	}
+}
+
+// NegotiateAccept will return the parameters of the first acceptable JSON:API media type of the request Accept header.
+// An array of Errors is returned if the Accept header contains the JSON:API media type, but every instance is modified
+// by unsupported parameters or extensions, or is excluded with a quality value of 0 (q=0).
+func NegotiateAccept(request *http.Request) func(supported MediaTypeParams) (MediaTypeParams, Errors) {
+	return func(supported MediaTypeParams) (MediaTypeParams, Errors) {
+		hasMediaType := false
+
+		for _, header := range request.Header.Values(Accept) {
+			for _, value := range strings.Split(header, ",") {
+				params, err := ParseMediaType(value)
+				if err == ErrNotMediaType {
+					continue
+				}
+				hasMediaType = true
+
+				if isExcluded(value) {
+					continue
+				}
+
+				if err == nil && supported.supportsExt(params.Ext) {
+					return params, nil
+				}
+			}
+		}
+
+		if !hasMediaType {
+			return MediaTypeParams{}, nil
+		}
+
+		return MediaTypeParams{}, Errors{
+			NotAcceptable(fmt.Sprintf("every instance of %s in the Accept header is modified by unsupported media type parameters or excluded with q=0", MediaType)),
+		}
+	}
+}
+
+// isExcluded checks if the Accept value has a quality value of 0, which marks the media type as not acceptable
+func isExcluded(value string) bool {
+	_, parameters, err := mime.ParseMediaType(value)
+	if err != nil {
+		return false
+	}
+
+	quality, exists := parameters[qualityParameter]
+	if !exists {
+		return false
+	}
+
+	weight, err := strconv.ParseFloat(quality, 64)
+	return err == nil && weight == 0
+}
+
+// NegotiateContent checks both the Content-Type and Accept headers of the request against the supported extensions.
+// The resulting MediaTypeParams hold the requested extensions and the provided, supported profiles to be applied to the response Content-Type.
+func NegotiateContent(request *http.Request) func(supported MediaTypeParams) (MediaTypeParams, Errors) {
+	return func(supported MediaTypeParams) (MediaTypeParams, Errors) {
+		if errs := CheckContentType(request)(supported); errs.HasErrors() {
+			return MediaTypeParams{}, errs
+		}
+
+		accepted, errs := NegotiateAccept(request)(supported)
+		if errs.HasErrors() {
+			return MediaTypeParams{}, errs
+		}
+
+		return MediaTypeParams{
+			Ext:     accepted.Ext,
+			Profile: supported.Profile,
+		}, nil
+	}
+}
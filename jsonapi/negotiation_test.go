@@ -0,0 +1,140 @@
+package jsonapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const atomicExt = "https://jsonapi.org/ext/atomic"
+
+func Test_ParseMediaType(t *testing.T) {
+	params, err := jsonapi.ParseMediaType(`application/vnd.api+json; ext="` + atomicExt + ` https://example.com/ext"; profile="` + jsonapi.CursorPaginationProfile + `"; q=0.9`)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{atomicExt, "https://example.com/ext"}, params.Ext)
+	assert.Equal(t, []string{jsonapi.CursorPaginationProfile}, params.Profile)
+}
+
+func Test_ParseMediaType_NotMediaType(t *testing.T) {
+	_, err := jsonapi.ParseMediaType("application/json")
+
+	assert.Equal(t, jsonapi.ErrNotMediaType, err)
+}
+
+func Test_ParseMediaType_UnsupportedParameter(t *testing.T) {
+	_, err := jsonapi.ParseMediaType("application/vnd.api+json; charset=utf-8")
+
+	assert.Equal(t, jsonapi.ErrUnsupportedMediaTypeParameter, err)
+}
+
+func Test_MediaTypeParams_String(t *testing.T) {
+	assert.Equal(t, jsonapi.MediaType, jsonapi.MediaTypeParams{}.String())
+	assert.Equal(t, `application/vnd.api+json; ext="a b"; profile="c"`, jsonapi.MediaTypeParams{Ext: []string{"a", "b"}, Profile: []string{"c"}}.String())
+}
+
+func Test_CheckContentType(t *testing.T) {
+	tests := []struct {
+		contentType string
+		hasErrors   bool
+	}{
+		{"", false},
+		{"application/json", false},
+		{jsonapi.MediaType, false},
+		{`application/vnd.api+json; ext="` + atomicExt + `"`, false},
+		{`application/vnd.api+json; profile="https://example.com/profile"`, false},
+		{`application/vnd.api+json; ext="https://example.com/ext"`, true},
+		{"application/vnd.api+json; charset=utf-8", true},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest("POST", "http://example.com/articles", nil)
+		req.Header.Set(jsonapi.ContentType, test.contentType)
+
+		errs := jsonapi.CheckContentType(req)(jsonapi.MediaTypeParams{Ext: []string{atomicExt}})
+
+		assert.Equal(t, test.hasErrors, errs.HasErrors(), test.contentType)
+		if test.hasErrors {
+			assert.Equal(t, http.StatusUnsupportedMediaType, errs[0].StatusCode())
+		}
+	}
+}
+
+func Test_NegotiateAccept(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/articles", nil)
+	req.Header.Add(jsonapi.Accept, "application/vnd.api+json; charset=utf-8, application/vnd.api+json; ext=\"https://example.com/ext\"")
+	req.Header.Add(jsonapi.Accept, `application/vnd.api+json; ext="`+atomicExt+`"`)
+
+	accepted, errs := jsonapi.NegotiateAccept(req)(jsonapi.MediaTypeParams{Ext: []string{atomicExt}})
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, []string{atomicExt}, accepted.Ext)
+}
+
+func Test_NegotiateAccept_OtherMediaTypes(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/articles", nil)
+	req.Header.Set(jsonapi.Accept, "text/html, */*")
+
+	accepted, errs := jsonapi.NegotiateAccept(req)(jsonapi.MediaTypeParams{})
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.MediaTypeParams{}, accepted)
+}
+
+func Test_NegotiateAccept_NotAcceptable(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/articles", nil)
+	req.Header.Set(jsonapi.Accept, "application/vnd.api+json; charset=utf-8, text/html")
+
+	_, errs := jsonapi.NegotiateAccept(req)(jsonapi.MediaTypeParams{})
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusNotAcceptable, errs[0].StatusCode())
+}
+
+func Test_NegotiateAccept_ExcludedQuality(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/articles", nil)
+	req.Header.Set(jsonapi.Accept, "application/vnd.api+json;q=0, text/html")
+
+	_, errs := jsonapi.NegotiateAccept(req)(jsonapi.MediaTypeParams{})
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusNotAcceptable, errs[0].StatusCode())
+}
+
+func Test_NegotiateAccept_ExcludedQuality_Alternative(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/articles", nil)
+	req.Header.Set(jsonapi.Accept, `application/vnd.api+json; ext="`+atomicExt+`"; q=0.0, application/vnd.api+json; q=0.5`)
+
+	accepted, errs := jsonapi.NegotiateAccept(req)(jsonapi.MediaTypeParams{Ext: []string{atomicExt}})
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.MediaTypeParams{}, accepted)
+}
+
+func Test_NegotiateContent(t *testing.T) {
+	req := httptest.NewRequest("POST", "http://example.com/articles", nil)
+	req.Header.Set(jsonapi.ContentType, jsonapi.MediaType)
+	req.Header.Set(jsonapi.Accept, jsonapi.MediaType)
+
+	applied, errs := jsonapi.NegotiateContent(req)(jsonapi.MediaTypeParams{
+		Ext:     []string{atomicExt},
+		Profile: []string{jsonapi.CursorPaginationProfile},
+	})
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.MediaTypeParams{Profile: []string{jsonapi.CursorPaginationProfile}}, applied)
+}
+
+func Test_NegotiateContent_UnsupportedMediaType(t *testing.T) {
+	req := httptest.NewRequest("POST", "http://example.com/articles", nil)
+	req.Header.Set(jsonapi.ContentType, "application/vnd.api+json; charset=utf-8")
+	req.Header.Set(jsonapi.Accept, "application/vnd.api+json; charset=utf-8")
+
+	_, errs := jsonapi.NegotiateContent(req)(jsonapi.MediaTypeParams{})
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusUnsupportedMediaType, errs[0].StatusCode())
+}
@@ -1,5 +1,11 @@
 package jsonapi
 
+import (
+	"log"
+	"net/http"
+	"reflect"
+)
+
 // Node is the standard JSONAPI Data struct
 type Node interface {
 	ID() string
@@ -25,15 +31,26 @@ type internalNode struct {
 	Meta          interface{}                     `json:"meta,omitempty"`
 }
 
-func transformResponseNode(response Response, baseURL string) (node interface{}, included []Node) {
+func transformResponseNode(response Response, baseURL string) (node interface{}, included []Node, errs Errors) {
 	if response.Errors.HasErrors() {
-		return nil, nil
+		return nil, nil, nil
+	}
+
+	if isNilPayload(response.Node) {
+		return internalNode{}, nil, nil
 	}
-	return transformNode(response.Node, baseURL)
+
+	if _, isNode := asNode(response.Node); !isNode {
+		return nil, nil, Errors{invalidNode(response.Node)}
+	}
+
+	node, included = transformNode(response.Node, baseURL)
+	return node, included, nil
 }
 
-func transformNode(node Node, baseURL string) (internalNode, []Node) {
-	if node == nil {
+func transformNode(payload interface{}, baseURL string) (internalNode, []Node) {
+	node, isNode := asNode(payload)
+	if !isNode {
 		return internalNode{}, nil
 	}
 
@@ -47,6 +64,10 @@ func transformNode(node Node, baseURL string) (internalNode, []Node) {
 		meta = metaNode.Meta()
 	}
 
+	if cursorNode, isCursorable := payload.(Cursorable); isCursorable {
+		meta = cursorMeta(meta, cursorNode.Cursor())
+	}
+
 	var attributes interface{} = node
 	if attributeNode, isAttributeable := node.(Attributeable); isAttributeable {
 		attributes = attributeNode.Attributes()
@@ -63,3 +84,19 @@ func transformNode(node Node, baseURL string) (internalNode, []Node) {
 		Relationships: relationships,
 	}, included
 }
+
+// invalidNode logs the type of the payload for the server, without exposing it in the error sent to the client
+func invalidNode(payload interface{}) Error {
+	log.Printf("jsonapi: %T is neither a Node nor a jsonapi tagged struct", payload)
+
+	return Error{
+		Status: Status(http.StatusInternalServerError),
+		Title:  "Invalid Response Node.",
+		Detail: "the response could not be rendered",
+	}
+}
+
+func isNilPayload(payload interface{}) bool {
+	value := reflect.ValueOf(payload)
+	return !value.IsValid() || (value.Kind() == reflect.Ptr && value.IsNil())
+}
@@ -1,6 +1,7 @@
 package jsonapi
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -126,10 +127,32 @@ func Test_transformResponseNode_WithErrors(t *testing.T) {
 		},
 	}
 
-	node, included := transformResponseNode(response, baseURL)
+	node, included, errs := transformResponseNode(response, baseURL)
 
 	assert.Nil(t, node)
 	assert.Nil(t, included)
+	assert.Nil(t, errs)
+}
+
+func Test_transformResponseNode_InvalidNode(t *testing.T) {
+	type untagged struct{ Name string }
+
+	node, included, errs := transformResponseNode(Response{Node: untagged{Name: "invalid"}}, baseURL)
+
+	assert.Nil(t, node)
+	assert.Nil(t, included)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusInternalServerError, errs[0].StatusCode())
+}
+
+func Test_transformResponseNode_NilNode(t *testing.T) {
+	var nilPointer *testStruct
+
+	_, _, errs := transformResponseNode(Response{Node: nilPointer}, baseURL)
+	assert.Nil(t, errs)
+
+	_, _, errs = transformResponseNode(Response{}, baseURL)
+	assert.Nil(t, errs)
 }
 
 func Test_transformNode_NilStruct(t *testing.T) {
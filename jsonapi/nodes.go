@@ -2,37 +2,81 @@ package jsonapi
 
 import "reflect"
 
-func transformCollectionResponseNodes(response CollectionResponse, baseURL string) (data interface{}, included []Node) {
+func transformCollectionResponseNodes(response CollectionResponse, baseURL string) (data interface{}, included []Node, errs Errors) {
 	if response.Errors.HasErrors() {
-		return nil, nil
+		return nil, nil, nil
 	}
-	return transformNodes(response.Nodes, baseURL)
+
+	if errs = invalidNodes(response.Nodes); errs.HasErrors() {
+		return nil, nil, errs
+	}
+
+	data, included = transformNodes(response.Nodes, baseURL)
+	return data, included, nil
 }
 
 func transformNodes(payload interface{}, baseURL string) ([]internalNode, []Node) {
 	internalNodes := make([]internalNode, 0)
 	included := make([]Node, 0)
 
-	appendNode := func(obj interface{}) {
-		if node, isNodeable := obj.(Node); isNodeable {
-			internalNode, inc := transformNode(node, baseURL)
-			internalNodes = append(internalNodes, internalNode)
-			included = append(included, inc...)
-		}
+	for _, node := range collectNodes(payload) {
+		internalNode, inc := transformNode(node, baseURL)
+		internalNodes = append(internalNodes, internalNode)
+		included = append(included, inc...)
 	}
 
+	return internalNodes, included
+}
+
+// collectNodes flattens a Node, []Node or pointers to either into a []Node
+func collectNodes(payload interface{}) (nodes []Node) {
 	switch vals := reflect.ValueOf(payload); vals.Kind() {
 	case reflect.Slice:
 		for x := 0; x < vals.Len(); x++ {
-			appendNode(vals.Index(x).Interface())
+			if node, isNodeable := asNode(vals.Index(x).Interface()); isNodeable {
+				nodes = append(nodes, node)
+			}
 		}
 
 	case reflect.Struct:
-		appendNode(vals.Interface())
+		if node, isNodeable := asNode(vals.Interface()); isNodeable {
+			nodes = append(nodes, node)
+		}
 
 	case reflect.Ptr:
-		return transformNodes(reflect.Indirect(vals).Interface(), baseURL)
+		if vals.IsNil() {
+			return nil
+		}
+		return collectNodes(reflect.Indirect(vals).Interface())
 	}
 
-	return internalNodes, included
+	return
+}
+
+// invalidNodes reports every member of a Node, []Node or pointers to either that collectNodes would drop
+func invalidNodes(payload interface{}) (errs Errors) {
+	switch vals := reflect.ValueOf(payload); vals.Kind() {
+	case reflect.Invalid:
+		return nil
+
+	case reflect.Slice:
+		for x := 0; x < vals.Len(); x++ {
+			if _, isNodeable := asNode(vals.Index(x).Interface()); !isNodeable {
+				errs = append(errs, invalidNode(vals.Index(x).Interface()))
+			}
+		}
+
+	case reflect.Ptr:
+		if vals.IsNil() {
+			return nil
+		}
+		return invalidNodes(reflect.Indirect(vals).Interface())
+
+	default:
+		if _, isNodeable := asNode(payload); !isNodeable {
+			errs = append(errs, invalidNode(payload))
+		}
+	}
+
+	return
 }
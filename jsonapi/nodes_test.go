@@ -1,6 +1,10 @@
 package jsonapi
 
 import (
+	"bytes"
+	"log"
+	"net/http"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -103,8 +107,29 @@ func Test_transformCollectionResponseNodes_WithErrors(t *testing.T) {
 		},
 	}
 
-	node, included := transformCollectionResponseNodes(response, baseURL)
+	node, included, errs := transformCollectionResponseNodes(response, baseURL)
 
 	assert.Nil(t, node)
 	assert.Nil(t, included)
+	assert.Nil(t, errs)
+}
+
+func Test_transformCollectionResponseNodes_InvalidNodes(t *testing.T) {
+	type untagged struct{ Name string }
+	response := CollectionResponse{
+		Nodes: []interface{}{testStruct{}, untagged{Name: "invalid"}},
+	}
+
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	node, included, errs := transformCollectionResponseNodes(response, baseURL)
+
+	assert.Nil(t, node)
+	assert.Nil(t, included)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusInternalServerError, errs[0].StatusCode())
+	assert.Equal(t, "the response could not be rendered", errs[0].Detail)
+	assert.Contains(t, logged.String(), "jsonapi.untagged is neither a Node nor a jsonapi tagged struct")
 }
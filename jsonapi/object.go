@@ -0,0 +1,63 @@
+package jsonapi
+
+// Version is the JSON:API specification version implemented by this package
+const Version string = "1.1"
+
+// JSONAPI is the top-level jsonapi object describing the server's implementation: https://jsonapi.org/format/#document-jsonapi-object
+type JSONAPI struct {
+	Version string      `json:"version,omitempty"`
+	Ext     []string    `json:"ext,omitempty"`     // URIs of every applied extension
+	Profile []string    `json:"profile,omitempty"` // URIs of every applied profile
+	Meta    interface{} `json:"meta,omitempty"`
+}
+
+// DefaultJSONAPI is the jsonapi object added to every response that does not provide its own, nil will omit the jsonapi member
+var DefaultJSONAPI *JSONAPI
+
+// HasProfile checks if the provided profile URI is applied
+func (object *JSONAPI) HasProfile(profile string) bool {
+	if object == nil {
+		return false
+	}
+
+	for _, p := range object.Profile {
+		if p == profile {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasExt checks if the provided extension URI is applied
+func (object *JSONAPI) HasExt(ext string) bool {
+	if object == nil {
+		return false
+	}
+
+	for _, e := range object.Ext {
+		if e == ext {
+			return true
+		}
+	}
+
+	return false
+}
+
+// transformJSONAPI selects the response jsonapi object over DefaultJSONAPI and fills in the implemented Version when missing
+func transformJSONAPI(object *JSONAPI) *JSONAPI {
+	if object == nil {
+		object = DefaultJSONAPI
+	}
+
+	if object == nil {
+		return nil
+	}
+
+	transformed := *object
+	if len(transformed.Version) == 0 {
+		transformed.Version = Version
+	}
+
+	return &transformed
+}
@@ -0,0 +1,68 @@
+package jsonapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TransformResponse_NoJSONAPI(t *testing.T) {
+	response := jsonapi.TransformResponse(jsonapi.Response{Node: Person{PersonID: "1"}}, "https://example.com")
+
+	assert.Nil(t, response.JSONAPI)
+}
+
+func Test_TransformResponse_JSONAPI(t *testing.T) {
+	response := jsonapi.TransformResponse(jsonapi.Response{
+		Node: Person{PersonID: "1"},
+		JSONAPI: &jsonapi.JSONAPI{
+			Profile: []string{jsonapi.CursorPaginationProfile},
+			Meta:    jsonapi.Meta{"copyright": "Example"},
+		},
+	}, "https://example.com")
+
+	got, err := json.Marshal(response.JSONAPI)
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"version": "1.1",
+		"profile": ["https://jsonapi.org/profiles/ethanresnick/cursor-pagination/"],
+		"meta": {"copyright": "Example"}
+	}`, string(got))
+}
+
+func Test_TransformCollectionResponse_DefaultJSONAPI(t *testing.T) {
+	jsonapi.DefaultJSONAPI = &jsonapi.JSONAPI{Version: "1.0", Ext: []string{"https://jsonapi.org/ext/atomic"}}
+	defer func() { jsonapi.DefaultJSONAPI = nil }()
+
+	response := jsonapi.TransformCollectionResponse(jsonapi.CollectionResponse{
+		Errors: jsonapi.Errors{{Title: "Something went wrong."}},
+	}, "https://example.com")
+
+	assert.Equal(t, &jsonapi.JSONAPI{Version: "1.0", Ext: []string{"https://jsonapi.org/ext/atomic"}}, response.JSONAPI)
+	assert.NotSame(t, jsonapi.DefaultJSONAPI, response.JSONAPI)
+
+	overridden := jsonapi.TransformCollectionResponse(jsonapi.CollectionResponse{
+		JSONAPI: &jsonapi.JSONAPI{},
+	}, "https://example.com")
+
+	assert.Equal(t, &jsonapi.JSONAPI{Version: jsonapi.Version}, overridden.JSONAPI)
+}
+
+func Test_JSONAPI_HasProfile(t *testing.T) {
+	object := &jsonapi.JSONAPI{Profile: []string{jsonapi.CursorPaginationProfile}}
+
+	assert.True(t, object.HasProfile(jsonapi.CursorPaginationProfile))
+	assert.False(t, object.HasProfile("https://example.com/profile"))
+	assert.False(t, (*jsonapi.JSONAPI)(nil).HasProfile(jsonapi.CursorPaginationProfile))
+}
+
+func Test_JSONAPI_HasExt(t *testing.T) {
+	object := &jsonapi.JSONAPI{Ext: []string{"https://jsonapi.org/ext/atomic"}}
+
+	assert.True(t, object.HasExt("https://jsonapi.org/ext/atomic"))
+	assert.False(t, object.HasExt("https://example.com/ext"))
+	assert.False(t, (*jsonapi.JSONAPI)(nil).HasExt("https://jsonapi.org/ext/atomic"))
+}
@@ -0,0 +1,296 @@
+package jsonapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+// PaginationStrategy identifies the pagination query parameters of a Page
+type PaginationStrategy string
+
+// Available pagination strategies
+const (
+	// OffsetStrategy paginates with PageOffset/PageLimit
+	OffsetStrategy PaginationStrategy = "offset"
+	// PageNumberStrategy paginates with PageNumber/PageSize
+	PageNumberStrategy PaginationStrategy = "number"
+	// CursorStrategy paginates with PageSize/PageBefore/PageAfter
+	CursorStrategy PaginationStrategy = "cursor"
+)
+
+// Paginator parses the Page of a request with the pagination strategy of the requested query parameters.
+// A strategy is only supported when its paginator is provided.
+type Paginator struct {
+	Offset     *OffsetPaginator
+	PageNumber *PageNumberPaginator
+	Cursor     *CursorPaginator
+	// Default is the strategy used when no pagination option (or only page[size]) is requested.
+	// Defaults to the first supported strategy of offset, page number and cursor.
+	Default PaginationStrategy
+}
+
+// Page is the page of a request parsed by a Paginator, exposing the bounds of the page to query regardless of the strategy
+type Page struct {
+	strategy PaginationStrategy
+	offset   OffsetPage
+	numbered NumberedPage
+	cursor   CursorPage
+}
+
+// Parse parses the requested page, unsupported or combined pagination strategies and invalid pagination options will return with an array of Errors.
+// The standalone page[cursor] option is not supported, cursor pagination uses page[before] and page[after].
+func (paginator Paginator) Parse(request *http.Request) (page Page, errs Errors) {
+	if PageCursor.QueryExists(request) {
+		return page, Errors{InvalidQueryParameter(PageCursor.String(), fmt.Sprintf("%s is not supported, use %s or %s for cursor pagination", PageCursor, PageAfter, PageBefore))}
+	}
+
+	if errs = CheckSupportedPagination(request)(paginator.supportedOptions()...); errs.HasErrors() {
+		return
+	}
+
+	strategy, errs := paginator.strategy(request)
+	if errs.HasErrors() {
+		return
+	}
+
+	page.strategy = strategy
+	switch strategy {
+	case OffsetStrategy:
+		page.offset, errs = paginator.Offset.Parse(request)
+	case PageNumberStrategy:
+		page.numbered, errs = paginator.PageNumber.Parse(request)
+	case CursorStrategy:
+		page.cursor, errs = paginator.Cursor.Parse(request)
+	}
+
+	return
+}
+
+func (paginator Paginator) supportedOptions() (options []PaginationOption) {
+	if paginator.Offset != nil {
+		options = append(options, PageOffset, PageLimit)
+	}
+	if paginator.PageNumber != nil {
+		options = append(options, PageNumber, PageSize)
+	}
+	if paginator.Cursor != nil {
+		options = append(options, PageSize, PageBefore, PageAfter)
+	}
+
+	return
+}
+
+func (paginator Paginator) isSupported(strategy PaginationStrategy) bool {
+	switch strategy {
+	case OffsetStrategy:
+		return paginator.Offset != nil
+	case PageNumberStrategy:
+		return paginator.PageNumber != nil
+	case CursorStrategy:
+		return paginator.Cursor != nil
+	}
+
+	return false
+}
+
+// strategy determines the pagination strategy of the requested pagination options, page[size] is shared by page number and cursor pagination
+func (paginator Paginator) strategy(request *http.Request) (strategy PaginationStrategy, errs Errors) {
+	var strategyOption PaginationOption
+	for _, option := range []PaginationOption{PageOffset, PageLimit, PageNumber, PageBefore, PageAfter, PageSize} {
+		if !option.QueryExists(request) {
+			continue
+		}
+
+		optionStrategy := optionStrategies[option]
+		if option == PageSize {
+			if strategy != OffsetStrategy {
+				continue
+			}
+			optionStrategy = PageNumberStrategy
+		}
+
+		if len(strategy) > 0 && optionStrategy != strategy {
+			return "", Errors{InvalidQueryParameter(option.String(), fmt.Sprintf("%s cannot be combined with %s", option, strategyOption))}
+		}
+
+		if len(strategy) == 0 {
+			strategy, strategyOption = optionStrategy, option
+		}
+	}
+
+	if len(strategy) > 0 {
+		return strategy, nil
+	}
+
+	// only page[size] or no pagination option was requested
+	if PageSize.QueryExists(request) && (paginator.Default == OffsetStrategy || !paginator.isSupported(paginator.Default)) {
+		if paginator.PageNumber != nil {
+			return PageNumberStrategy, nil
+		}
+		return CursorStrategy, nil
+	}
+
+	if paginator.isSupported(paginator.Default) {
+		return paginator.Default, nil
+	}
+
+	for _, strategy := range []PaginationStrategy{OffsetStrategy, PageNumberStrategy, CursorStrategy} {
+		if paginator.isSupported(strategy) {
+			return strategy, nil
+		}
+	}
+
+	return "", nil
+}
+
+var optionStrategies = map[PaginationOption]PaginationStrategy{
+	PageOffset: OffsetStrategy,
+	PageLimit:  OffsetStrategy,
+	PageNumber: PageNumberStrategy,
+	PageBefore: CursorStrategy,
+	PageAfter:  CursorStrategy,
+}
+
+// Strategy returns the pagination strategy of the page, empty when the Paginator supports none
+func (page Page) Strategy() PaginationStrategy {
+	return page.strategy
+}
+
+// Limit returns the maximum number of resources in the page.
+// Fetch Limit()+1 rows to find out whether more results are available beyond the page.
+func (page Page) Limit() int {
+	switch page.strategy {
+	case OffsetStrategy:
+		return page.offset.Limit
+	case PageNumberStrategy:
+		return page.numbered.Limit()
+	case CursorStrategy:
+		return page.cursor.Size
+	}
+
+	return 0
+}
+
+// Offset returns the number of resources before the page, always 0 for cursor pagination
+func (page Page) Offset() int {
+	switch page.strategy {
+	case OffsetStrategy:
+		return page.offset.Offset
+	case PageNumberStrategy:
+		return page.numbered.Offset()
+	}
+
+	return 0
+}
+
+// Before returns the page[before] cursor bounding the keyset of the page, empty when not requested
+func (page Page) Before() string {
+	return page.cursor.Before
+}
+
+// After returns the page[after] cursor bounding the keyset of the page, empty when not requested
+func (page Page) After() string {
+	return page.cursor.After
+}
+
+// IsBackward checks if the resources immediately before the page[before] cursor are requested,
+// in which case the keyset is queried in reverse order and the fetched rows should be reversed to the requested order
+func (page Page) IsBackward() bool {
+	return page.cursor.IsBackward()
+}
+
+// Result creates the Result of the page from the fetched resources (at most Limit()) and whether more results are available
+func (page Page) Result(nodes interface{}, moreResultsAvailable bool) Result {
+	return Result{
+		Page:                 page,
+		Nodes:                nodes,
+		MoreResultsAvailable: moreResultsAvailable,
+	}
+}
+
+// Result is the page of resources fetched for a Page, which creates the pagination Links and meta of a CollectionResponse
+//
+//	result := page.Result(articles, more)
+//	jsonapi.CollectionResponse{Nodes: articles, Links: result.Links(jsonapi.Link{Href: "/articles"}), Meta: result.Meta()}
+type Result struct {
+	Page Page
+	// Nodes are the fetched resources, the cursors of cursor pagination links are taken from the first and last Cursorable resources.
+	Nodes interface{}
+	// MoreResultsAvailable reports that more resources exist in the direction of pagination, or that a range was truncated.
+	MoreResultsAvailable bool
+	// Total is the total number of resources when known, which adds the last link and the page meta of offset and page number pagination.
+	Total *int
+}
+
+// Links creates the pagination links of the result
+func (result Result) Links(link Link) Links {
+	page := result.Page
+
+	switch page.strategy {
+	case OffsetStrategy:
+		return page.offset.Links(link, result.MoreResultsAvailable, result.Total)
+
+	case PageNumberStrategy:
+		if result.Total != nil {
+			return page.numbered.Links(link, *result.Total)
+		}
+
+		links := Links{
+			FirstKey: page.numbered.link(link, page.numbered.FirstPage),
+		}
+		if page.numbered.Number > page.numbered.FirstPage {
+			links[PreviousKey] = page.numbered.link(link, page.numbered.Number-1)
+		}
+		if result.MoreResultsAvailable {
+			links[NextKey] = page.numbered.link(link, page.numbered.Number+1)
+		}
+		return links
+
+	case CursorStrategy:
+		firstCursor, lastCursor := boundingCursors(result.Nodes)
+		return page.cursor.Links(link, firstCursor, lastCursor, result.MoreResultsAvailable)
+	}
+
+	return nil
+}
+
+// Meta creates the page meta of the result, nil when there is none
+func (result Result) Meta() Meta {
+	page := result.Page
+
+	switch page.strategy {
+	case OffsetStrategy:
+		if result.Total != nil {
+			return page.offset.Meta(*result.Total)
+		}
+
+	case PageNumberStrategy:
+		if result.Total != nil {
+			return page.numbered.Meta(*result.Total)
+		}
+
+	case CursorStrategy:
+		return page.cursor.Meta(result.MoreResultsAvailable)
+	}
+
+	return nil
+}
+
+// boundingCursors returns the cursors of the first and last resources of the provided slice
+func boundingCursors(nodes interface{}) (firstCursor string, lastCursor string) {
+	value := reflect.ValueOf(nodes)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array || value.Len() == 0 {
+		return
+	}
+
+	if first, isCursorable := value.Index(0).Interface().(Cursorable); isCursorable {
+		firstCursor = first.Cursor()
+	}
+
+	if last, isCursorable := value.Index(value.Len() - 1).Interface().(Cursorable); isCursorable {
+		lastCursor = last.Cursor()
+	}
+
+	return
+}
@@ -0,0 +1,200 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Cursorable method for the cursor of a resource, which is added to the meta of the resource object as {"page": {"cursor"}}
+// as required by the cursor pagination profile: https://jsonapi.org/profiles/ethanresnick/cursor-pagination/
+type Cursorable interface {
+	Cursor() string
+}
+
+// CursorPaginator parses cursor-based pagination (using PageSize/PageBefore/PageAfter) of the cursor pagination profile
+// and generates the links and meta of the requested page: https://jsonapi.org/profiles/ethanresnick/cursor-pagination/
+type CursorPaginator struct {
+	// DefaultSize is the page size used when page[size] is not requested. Defaults to DefaultPageSize, and never exceeds MaxSize.
+	DefaultSize int
+	// MaxSize is the maximum page size that can be requested. There is no maximum when 0.
+	MaxSize int
+	// RangePagination allows requesting both page[before] and page[after], otherwise a range-pagination-not-supported error is returned.
+	RangePagination bool
+}
+
+// CursorPage is a page requested with PageSize/PageBefore/PageAfter, the cursors are empty when not requested
+type CursorPage struct {
+	Size   int
+	Before string
+	After  string
+}
+
+// Parse parses the requested page, a page[size] that is not a positive integer or exceeds the maximum, an empty cursor,
+// or a range when range pagination is not supported will return with an array of Errors
+func (paginator CursorPaginator) Parse(request *http.Request) (page CursorPage, errs Errors) {
+	size, sizeErrs := getPageInteger(request, PageSize, 1, paginator.defaultSize())
+	for _, err := range sizeErrs {
+		errs = append(errs, invalidParameterValueError(err))
+	}
+	page.Size = size
+
+	if paginator.MaxSize > 0 && size > paginator.MaxSize {
+		errs = append(errs, pageSizeTooLargeError(size, paginator.MaxSize))
+	}
+
+	var cursorErrs Errors
+	page.Before, cursorErrs = getPageCursor(request, PageBefore)
+	errs = append(errs, cursorErrs...)
+
+	page.After, cursorErrs = getPageCursor(request, PageAfter)
+	errs = append(errs, cursorErrs...)
+
+	if page.IsRange() && !paginator.RangePagination {
+		errs = append(errs, CheckUnsupportedPagination(request)(PageBefore)...)
+	}
+
+	return
+}
+
+func (paginator CursorPaginator) defaultSize() int {
+	size := paginator.DefaultSize
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+
+	if paginator.MaxSize > 0 && size > paginator.MaxSize {
+		return paginator.MaxSize
+	}
+
+	return size
+}
+
+func getPageCursor(request *http.Request, option PaginationOption) (string, Errors) {
+	cursor, exists := getQueryString(request, option)
+	if exists && len(cursor) == 0 {
+		return "", Errors{invalidParameterValueError(InvalidQueryParameter(option.String(), fmt.Sprintf("%s must not be empty", option)))}
+	}
+
+	return cursor, nil
+}
+
+func invalidParameterValueError(err Error) Error {
+	err.Links = Links{
+		TypeKey: {
+			Href: CursorPaginationProfile + "#auto-id--invalid-parameter-value-error",
+		},
+	}
+
+	return err
+}
+
+// IsRange checks if the page was requested with both page[before] and page[after]
+func (page CursorPage) IsRange() bool {
+	return len(page.Before) > 0 && len(page.After) > 0
+}
+
+// IsBackward checks if the page was requested with only page[before], in which case the resources immediately before the cursor are requested
+func (page CursorPage) IsBackward() bool {
+	return len(page.Before) > 0 && len(page.After) == 0
+}
+
+// Links creates the prev and next pagination links of the page from the cursors of its first and last resource (empty when the page is empty).
+// moreResultsAvailable reports that there are more resources in the direction of pagination, or that a range was truncated.
+// Links that are not available are omitted.
+func (page CursorPage) Links(link Link, firstCursor string, lastCursor string, moreResultsAvailable bool) Links {
+	links := make(Links)
+
+	// an empty page links back to the cursors of the request
+	if len(firstCursor) == 0 {
+		firstCursor = page.After
+	}
+	if len(lastCursor) == 0 {
+		lastCursor = page.Before
+	}
+
+	switch {
+	case page.IsRange():
+		if moreResultsAvailable && len(lastCursor) > 0 {
+			links[NextKey] = page.link(link, Queries{PageAfter.String(): lastCursor, PageBefore.String(): page.Before})
+		}
+
+	case page.IsBackward():
+		if moreResultsAvailable && len(firstCursor) > 0 {
+			links[PreviousKey] = page.link(link, Queries{PageBefore.String(): firstCursor})
+		}
+		if len(lastCursor) > 0 {
+			links[NextKey] = page.link(link, Queries{PageAfter.String(): lastCursor})
+		}
+
+	default:
+		if len(page.After) > 0 && len(firstCursor) > 0 {
+			links[PreviousKey] = page.link(link, Queries{PageBefore.String(): firstCursor})
+		}
+		if moreResultsAvailable && len(lastCursor) > 0 {
+			links[NextKey] = page.link(link, Queries{PageAfter.String(): lastCursor})
+		}
+	}
+
+	return links
+}
+
+func (page CursorPage) link(link Link, cursors Queries) Link {
+	cursors[PageSize.String()] = page.Size
+
+	return paginationLink(link, cursors)
+}
+
+// Meta creates the page meta of a range request: {"page": {"rangeTruncated"}}, where rangeTruncated reports that more
+// resources than the page size exist between the cursors. There is no page meta for other requests.
+func (page CursorPage) Meta(rangeTruncated bool) Meta {
+	if !page.IsRange() {
+		return nil
+	}
+
+	return Meta{
+		"page": Meta{
+			"rangeTruncated": rangeTruncated,
+		},
+	}
+}
+
+// cursorMeta adds the cursor of the resource to its meta object
+func cursorMeta(meta interface{}, cursor string) interface{} {
+	page := Meta{"cursor": cursor}
+
+	switch values := meta.(type) {
+	case nil:
+		return Meta{"page": page}
+
+	case Meta:
+		merged := make(Meta, len(values)+1)
+		for key, value := range values {
+			merged[key] = value
+		}
+		existing, isMeta := values["page"].(Meta)
+		if !isMeta {
+			existing, _ = values["page"].(map[string]interface{})
+		}
+		for key, value := range existing {
+			if _, exists := page[key]; !exists {
+				page[key] = value
+			}
+		}
+		merged["page"] = page
+
+		return merged
+	}
+
+	marshalled, err := json.Marshal(meta)
+	if err != nil {
+		return meta
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal(marshalled, &values); err != nil {
+		return meta
+	}
+
+	return cursorMeta(Meta(values), cursor)
+}
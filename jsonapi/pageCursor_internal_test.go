@@ -0,0 +1,24 @@
+package jsonapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_cursorMeta(t *testing.T) {
+	assert.Equal(t, Meta{"page": Meta{"cursor": "abc"}}, cursorMeta(nil, "abc"))
+
+	meta := Meta{"views": 10, "page": Meta{"cursor": "old", "index": 1}}
+	assert.Equal(t, Meta{"views": 10, "page": Meta{"cursor": "abc", "index": 1}}, cursorMeta(meta, "abc"))
+	assert.Equal(t, "old", meta["page"].(Meta)["cursor"])
+
+	type structMeta struct {
+		Views int `json:"views"`
+	}
+	assert.Equal(t, Meta{"views": float64(10), "page": Meta{"cursor": "abc"}}, cursorMeta(structMeta{Views: 10}, "abc"))
+	assert.Equal(t, Meta{"page": Meta{"cursor": "abc", "index": float64(1)}}, cursorMeta(struct {
+		Page Meta `json:"page"`
+	}{Page: Meta{"index": 1}}, "abc"))
+	assert.Equal(t, "not an object", cursorMeta("not an object", "abc"))
+}
@@ -0,0 +1,203 @@
+package jsonapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+type CursorArticle struct {
+	ArticleID string `json:"-"`
+	Title     string `json:"title"`
+}
+
+func (article CursorArticle) ID() string {
+	return article.ArticleID
+}
+
+func (article CursorArticle) Type() string {
+	return "articles"
+}
+
+func (article CursorArticle) Cursor() string {
+	return "cursor-" + article.ArticleID
+}
+
+type MetaCursorArticle struct {
+	CursorArticle
+}
+
+func (article MetaCursorArticle) Meta() interface{} {
+	return jsonapi.Meta{"views": 10}
+}
+
+func Test_CursorPaginator_Parse(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[size]=10&page[after]=abc", nil)
+
+	page, errs := jsonapi.CursorPaginator{}.Parse(req)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.CursorPage{Size: 10, After: "abc"}, page)
+	assert.False(t, page.IsRange())
+	assert.False(t, page.IsBackward())
+}
+
+func Test_CursorPaginator_Parse_Defaults(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example", nil)
+
+	page, errs := jsonapi.CursorPaginator{}.Parse(req)
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.CursorPage{Size: jsonapi.DefaultPageSize}, page)
+
+	page, errs = jsonapi.CursorPaginator{DefaultSize: 50, MaxSize: 25}.Parse(req)
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.CursorPage{Size: 25}, page)
+}
+
+func Test_CursorPaginator_Parse_Invalid(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[size]=0&page[before]=", nil)
+
+	_, errs := jsonapi.CursorPaginator{}.Parse(req)
+
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "page[size]"}, errs[0].Source)
+	assert.Equal(t, jsonapi.CursorPaginationProfile+"#auto-id--invalid-parameter-value-error", errs[0].Links[jsonapi.TypeKey].Href)
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "page[before]"}, errs[1].Source)
+	assert.Equal(t, "page[before] must not be empty", errs[1].Detail)
+}
+
+func Test_CursorPaginator_Parse_ExceedsMaximum(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[size]=200", nil)
+
+	_, errs := jsonapi.CursorPaginator{MaxSize: 100}.Parse(req)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, jsonapi.Meta{"page": jsonapi.Meta{"maxSize": 100}}, errs[0].Meta)
+}
+
+func Test_CursorPaginator_Parse_Range(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[before]=xyz&page[after]=abc", nil)
+
+	_, errs := jsonapi.CursorPaginator{}.Parse(req)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, "Range Pagination Not Supported.", errs[0].Title)
+
+	page, errs := jsonapi.CursorPaginator{RangePagination: true}.Parse(req)
+
+	assert.False(t, errs.HasErrors())
+	assert.True(t, page.IsRange())
+}
+
+func Test_CursorPage_Links_Forward(t *testing.T) {
+	page := jsonapi.CursorPage{Size: 10, After: "abc"}
+
+	links := jsonapi.TransformLinks(page.Links(jsonapi.Link{Href: "/articles"}, "c1", "c10", true), "http://example.com")
+
+	assert.Equal(t, jsonapi.LinkMap{
+		jsonapi.PreviousKey: "http://example.com/articles?page[before]=c1&page[size]=10",
+		jsonapi.NextKey:     "http://example.com/articles?page[after]=c10&page[size]=10",
+	}, links)
+}
+
+func Test_CursorPage_Links_FirstPage(t *testing.T) {
+	page := jsonapi.CursorPage{Size: 10}
+
+	links := page.Links(jsonapi.Link{Href: "/articles"}, "c1", "c10", false)
+
+	assert.Equal(t, 0, len(links))
+}
+
+func Test_CursorPage_Links_Backward(t *testing.T) {
+	page := jsonapi.CursorPage{Size: 10, Before: "xyz"}
+
+	links := page.Links(jsonapi.Link{Href: "/articles"}, "c1", "c10", false)
+
+	assert.Equal(t, 1, len(links))
+	assert.Equal(t, "c10", links[jsonapi.NextKey].Queries[jsonapi.PageAfter.String()])
+}
+
+func Test_CursorPage_Links_Empty(t *testing.T) {
+	page := jsonapi.CursorPage{Size: 10, After: "abc"}
+
+	links := page.Links(jsonapi.Link{Href: "/articles"}, "", "", false)
+
+	assert.Equal(t, 1, len(links))
+	assert.Equal(t, "abc", links[jsonapi.PreviousKey].Queries[jsonapi.PageBefore.String()])
+}
+
+func Test_CursorPage_Links_Range(t *testing.T) {
+	page := jsonapi.CursorPage{Size: 10, Before: "xyz", After: "abc"}
+
+	links := page.Links(jsonapi.Link{Href: "/articles"}, "c1", "c10", true)
+
+	assert.Equal(t, 1, len(links))
+	assert.Equal(t, jsonapi.Queries{
+		jsonapi.PageAfter.String():  "c10",
+		jsonapi.PageBefore.String(): "xyz",
+		jsonapi.PageSize.String():   10,
+	}, links[jsonapi.NextKey].Queries)
+}
+
+func Test_CursorPage_Meta(t *testing.T) {
+	assert.Nil(t, jsonapi.CursorPage{Size: 10, After: "abc"}.Meta(true))
+	assert.Equal(t, jsonapi.Meta{"page": jsonapi.Meta{"rangeTruncated": true}}, jsonapi.CursorPage{Size: 10, Before: "xyz", After: "abc"}.Meta(true))
+}
+
+func Test_CreateCollectionResponse_Cursor(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/articles", nil)
+
+	response := jsonapi.CreateCollectionResponse(req)(jsonapi.CollectionResponse{
+		Nodes: []interface{}{
+			CursorArticle{ArticleID: "1", Title: "First"},
+			MetaCursorArticle{CursorArticle{ArticleID: "2", Title: "Second"}},
+		},
+	})
+	response.Links = nil
+
+	got, err := json.Marshal(response)
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"data": [
+			{
+				"id": "1",
+				"type": "articles",
+				"attributes": {"title": "First"},
+				"meta": {"page": {"cursor": "cursor-1"}}
+			},
+			{
+				"id": "2",
+				"type": "articles",
+				"attributes": {"title": "Second"},
+				"meta": {"views": 10, "page": {"cursor": "cursor-2"}}
+			}
+		]
+	}`, string(got))
+}
+
+func Test_CursorPage_Links_ReservedCharacters(t *testing.T) {
+	page := jsonapi.CursorPage{Size: 20, After: "x&y=z#w"}
+
+	links := jsonapi.TransformLinks(page.Links(jsonapi.Link{Href: "/articles"}, "a+b/c=", "x&y=z#w", true), "http://example.com")
+
+	assert.Equal(t, "http://example.com/articles?page[after]=x%26y%3Dz%23w&page[size]=20", links[jsonapi.NextKey])
+	assert.Equal(t, "http://example.com/articles?page[before]=a%2Bb%2Fc%3D&page[size]=20", links[jsonapi.PreviousKey])
+
+	req := httptest.NewRequest("GET", links[jsonapi.PreviousKey].(string), nil)
+	before, _ := jsonapi.GetPageBefore(req)
+	assert.Equal(t, "a+b/c=", before)
+}
+
+func Test_CreateCollectionResponse_SelfLink_ReservedCharacters(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/articles?page[after]=x%26y%3Dz%23w", nil)
+
+	response := jsonapi.CreateCollectionResponse(req)(jsonapi.CollectionResponse{})
+
+	assert.Equal(t, "http://example.com/articles?page[after]=x%26y%3Dz%23w", response.Links[jsonapi.SelfKey])
+}
@@ -0,0 +1,124 @@
+package jsonapi
+
+import "net/http"
+
+// PageNumberPaginator parses page-based pagination (using PageNumber/PageSize) and generates the links and meta of the requested page
+type PageNumberPaginator struct {
+	// FirstPage is the number of the first page: 0 for 0-based or 1 for 1-based page numbers.
+	FirstPage int
+	// DefaultSize is the page size used when page[size] is not requested. Defaults to DefaultPageSize, limited by MaxSize.
+	DefaultSize int
+	// MaxSize is the maximum page size that can be requested. There is no maximum when 0.
+	MaxSize int
+}
+
+// NumberedPage is a page requested with PageNumber/PageSize
+type NumberedPage struct {
+	Number    int
+	Size      int
+	FirstPage int
+}
+
+// Parse parses the requested page, a page[number] before the first page or a page[size] that is not a positive integer
+// or exceeds the maximum will return with an array of Errors
+func (paginator PageNumberPaginator) Parse(request *http.Request) (page NumberedPage, errs Errors) {
+	page.FirstPage = paginator.FirstPage
+
+	number, numberErrs := getPageInteger(request, PageNumber, paginator.FirstPage, paginator.FirstPage)
+	errs = append(errs, numberErrs...)
+	page.Number = number
+
+	size, sizeErrs := getPageInteger(request, PageSize, 1, paginator.defaultSize())
+	errs = append(errs, sizeErrs...)
+	page.Size = size
+
+	if paginator.MaxSize > 0 && size > paginator.MaxSize {
+		errs = append(errs, pageSizeTooLargeError(size, paginator.MaxSize))
+	}
+
+	return
+}
+
+func (paginator PageNumberPaginator) defaultSize() int {
+	size := paginator.DefaultSize
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+
+	if paginator.MaxSize > 0 && size > paginator.MaxSize {
+		return paginator.MaxSize
+	}
+
+	return size
+}
+
+// Offset returns the number of resources before the page
+func (page NumberedPage) Offset() int {
+	return (page.Number - page.FirstPage) * page.Size
+}
+
+// Limit returns the maximum number of resources in the page
+func (page NumberedPage) Limit() int {
+	return page.Size
+}
+
+// TotalPages returns the number of pages needed for the total number of resources
+func (page NumberedPage) TotalPages(total int) int {
+	if total <= 0 || page.Size <= 0 {
+		return 0
+	}
+
+	return (total + page.Size - 1) / page.Size
+}
+
+// LastPage returns the number of the last page for the total number of resources, which is the first page when there are no resources
+func (page NumberedPage) LastPage(total int) int {
+	if totalPages := page.TotalPages(total); totalPages > 0 {
+		return page.FirstPage + totalPages - 1
+	}
+
+	return page.FirstPage
+}
+
+// Links creates the first, prev, next and last pagination links of the page for the total number of resources.
+// The prev and next links are omitted on the first and last page respectively.
+func (page NumberedPage) Links(link Link, total int) Links {
+	lastPage := page.LastPage(total)
+
+	links := Links{
+		FirstKey: page.link(link, page.FirstPage),
+		LastKey:  page.link(link, lastPage),
+	}
+
+	// a page after the last page links back to the last page
+	if page.Number > lastPage {
+		links[PreviousKey] = page.link(link, lastPage)
+	} else if page.Number > page.FirstPage {
+		links[PreviousKey] = page.link(link, page.Number-1)
+	}
+
+	if page.Number < lastPage {
+		links[NextKey] = page.link(link, page.Number+1)
+	}
+
+	return links
+}
+
+func (page NumberedPage) link(link Link, number int) Link {
+	return paginationLink(link, Queries{
+		PageNumber.String(): number,
+		PageSize.String():   page.Size,
+	})
+}
+
+// Meta creates the page meta of the page for the total number of resources: {"page": {"total", "totalPages", "number", "size"}}
+func (page NumberedPage) Meta(total int) Meta {
+	return Meta{
+		"page": Meta{
+			"total":      total,
+			"totalPages": page.TotalPages(total),
+			"number":     page.Number,
+			"size":       page.Size,
+		},
+	}
+}
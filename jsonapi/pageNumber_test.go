@@ -0,0 +1,115 @@
+package jsonapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PageNumberPaginator_Parse(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[number]=3&page[size]=10", nil)
+
+	page, errs := jsonapi.PageNumberPaginator{FirstPage: 1}.Parse(req)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.NumberedPage{Number: 3, Size: 10, FirstPage: 1}, page)
+	assert.Equal(t, 20, page.Offset())
+	assert.Equal(t, 10, page.Limit())
+}
+
+func Test_PageNumberPaginator_Parse_Defaults(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example", nil)
+
+	page, errs := jsonapi.PageNumberPaginator{FirstPage: 1}.Parse(req)
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.NumberedPage{Number: 1, Size: jsonapi.DefaultPageSize, FirstPage: 1}, page)
+	assert.Equal(t, 0, page.Offset())
+
+	page, errs = jsonapi.PageNumberPaginator{DefaultSize: 50, MaxSize: 25}.Parse(req)
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.NumberedPage{Number: 0, Size: 25}, page)
+}
+
+func Test_PageNumberPaginator_Parse_Invalid(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[number]=0&page[size]=ten", nil)
+
+	_, errs := jsonapi.PageNumberPaginator{FirstPage: 1}.Parse(req)
+
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, "page[number] must be an integer of at least 1", errs[0].Detail)
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "page[number]"}, errs[0].Source)
+	assert.Equal(t, "page[size] must be an integer of at least 1", errs[1].Detail)
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "page[size]"}, errs[1].Source)
+}
+
+func Test_PageNumberPaginator_Parse_ExceedsMaximum(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[size]=200", nil)
+
+	_, errs := jsonapi.PageNumberPaginator{MaxSize: 100}.Parse(req)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "page[size]"}, errs[0].Source)
+	assert.Equal(t, jsonapi.Meta{"page": jsonapi.Meta{"maxSize": 100}}, errs[0].Meta)
+}
+
+func Test_NumberedPage_TotalPages(t *testing.T) {
+	page := jsonapi.NumberedPage{Number: 1, Size: 10, FirstPage: 1}
+
+	assert.Equal(t, 0, page.TotalPages(0))
+	assert.Equal(t, 1, page.TotalPages(10))
+	assert.Equal(t, 3, page.TotalPages(21))
+	assert.Equal(t, 1, page.LastPage(0))
+	assert.Equal(t, 3, page.LastPage(21))
+}
+
+func Test_NumberedPage_Links(t *testing.T) {
+	page := jsonapi.NumberedPage{Number: 2, Size: 10, FirstPage: 1}
+	link := jsonapi.Link{Href: "/articles", Queries: jsonapi.Queries{"sort": "title"}}
+
+	links := jsonapi.TransformLinks(page.Links(link, 35), "http://example.com")
+
+	assert.Equal(t, jsonapi.LinkMap{
+		jsonapi.FirstKey:    "http://example.com/articles?page[number]=1&page[size]=10&sort=title",
+		jsonapi.PreviousKey: "http://example.com/articles?page[number]=1&page[size]=10&sort=title",
+		jsonapi.NextKey:     "http://example.com/articles?page[number]=3&page[size]=10&sort=title",
+		jsonapi.LastKey:     "http://example.com/articles?page[number]=4&page[size]=10&sort=title",
+	}, links)
+	assert.Equal(t, jsonapi.Queries{"sort": "title"}, link.Queries)
+}
+
+func Test_NumberedPage_Links_FirstAndLast(t *testing.T) {
+	page := jsonapi.NumberedPage{Number: 0, Size: 10}
+
+	links := page.Links(jsonapi.Link{Href: "/articles"}, 5)
+
+	assert.Equal(t, 2, len(links))
+	assert.Equal(t, 0, links[jsonapi.FirstKey].Queries[jsonapi.PageNumber.String()])
+	assert.Equal(t, 0, links[jsonapi.LastKey].Queries[jsonapi.PageNumber.String()])
+}
+
+func Test_NumberedPage_Links_AfterLast(t *testing.T) {
+	page := jsonapi.NumberedPage{Number: 9, Size: 10, FirstPage: 1}
+
+	links := page.Links(jsonapi.Link{Href: "/articles"}, 15)
+
+	assert.Equal(t, 2, links[jsonapi.PreviousKey].Queries[jsonapi.PageNumber.String()])
+	assert.NotContains(t, links, jsonapi.NextKey)
+}
+
+func Test_NumberedPage_Meta(t *testing.T) {
+	page := jsonapi.NumberedPage{Number: 2, Size: 10, FirstPage: 1}
+
+	assert.Equal(t, jsonapi.Meta{
+		"page": jsonapi.Meta{
+			"total":      35,
+			"totalPages": 4,
+			"number":     2,
+			"size":       10,
+		},
+	}, page.Meta(35))
+}
@@ -0,0 +1,112 @@
+package jsonapi
+
+import "net/http"
+
+// OffsetPaginator parses offset-based pagination (using PageOffset/PageLimit) and generates the links and meta of the requested page
+type OffsetPaginator struct {
+	// DefaultLimit is the page limit used when page[limit] is not requested. Defaults to DefaultPageSize, limited by MaxLimit.
+	DefaultLimit int
+	// MaxLimit is the maximum page limit that can be requested. There is no maximum when 0.
+	MaxLimit int
+}
+
+// OffsetPage is a page requested with PageOffset/PageLimit
+type OffsetPage struct {
+	Offset int
+	Limit  int
+}
+
+// Parse parses the requested page, a negative or non-numeric page[offset] and a page[limit] that is not a positive integer
+// or exceeds the maximum will return with an array of Errors
+func (paginator OffsetPaginator) Parse(request *http.Request) (page OffsetPage, errs Errors) {
+	offset, offsetErrs := getPageInteger(request, PageOffset, 0, 0)
+	errs = append(errs, offsetErrs...)
+	page.Offset = offset
+
+	limit, limitErrs := getPageInteger(request, PageLimit, 1, paginator.defaultLimit())
+	errs = append(errs, limitErrs...)
+	page.Limit = limit
+
+	if paginator.MaxLimit > 0 && limit > paginator.MaxLimit {
+		errs = append(errs, pageLimitTooLargeError(limit, paginator.MaxLimit))
+	}
+
+	return
+}
+
+func (paginator OffsetPaginator) defaultLimit() int {
+	limit := paginator.DefaultLimit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+
+	if paginator.MaxLimit > 0 && limit > paginator.MaxLimit {
+		return paginator.MaxLimit
+	}
+
+	return limit
+}
+
+// Links creates the first, prev and next pagination links of the page, along with the last link when the total number of resources is provided.
+// The next link is created while the page does not reach the total, or when moreResultsAvailable without a total.
+func (page OffsetPage) Links(link Link, moreResultsAvailable bool, total *int) Links {
+	links := Links{
+		FirstKey: page.link(link, 0),
+	}
+
+	if page.Offset > 0 {
+		previous := page.Offset - page.Limit
+		if previous < 0 {
+			previous = 0
+		}
+		links[PreviousKey] = page.link(link, previous)
+	}
+
+	if total != nil {
+		moreResultsAvailable = page.Offset+page.Limit < *total
+
+		links[LastKey] = page.link(link, page.lastOffset(*total))
+	}
+
+	if moreResultsAvailable {
+		links[NextKey] = page.link(link, page.Offset+page.Limit)
+	}
+
+	return links
+}
+
+// lastOffset is the offset of the last page on the grid of the current offset, so that following next links reaches it
+func (page OffsetPage) lastOffset(total int) int {
+	if page.Limit <= 0 {
+		return 0
+	}
+
+	if page.Offset < total {
+		return page.Offset + ((total-1-page.Offset)/page.Limit)*page.Limit
+	}
+
+	last := page.Offset - ((page.Offset-total)/page.Limit+1)*page.Limit
+	if last < 0 {
+		return 0
+	}
+
+	return last
+}
+
+func (page OffsetPage) link(link Link, offset int) Link {
+	return paginationLink(link, Queries{
+		PageOffset.String(): offset,
+		PageLimit.String():  page.Limit,
+	})
+}
+
+// Meta creates the page meta of the page for the total number of resources: {"page": {"total", "offset", "limit"}}
+func (page OffsetPage) Meta(total int) Meta {
+	return Meta{
+		"page": Meta{
+			"total":  total,
+			"offset": page.Offset,
+			"limit":  page.Limit,
+		},
+	}
+}
@@ -0,0 +1,136 @@
+package jsonapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OffsetPaginator_Parse(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[offset]=20&page[limit]=10", nil)
+
+	page, errs := jsonapi.OffsetPaginator{}.Parse(req)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.OffsetPage{Offset: 20, Limit: 10}, page)
+}
+
+func Test_OffsetPaginator_Parse_Defaults(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example", nil)
+
+	page, errs := jsonapi.OffsetPaginator{}.Parse(req)
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.OffsetPage{Offset: 0, Limit: jsonapi.DefaultPageSize}, page)
+
+	page, errs = jsonapi.OffsetPaginator{DefaultLimit: 50, MaxLimit: 25}.Parse(req)
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.OffsetPage{Offset: 0, Limit: 25}, page)
+}
+
+func Test_OffsetPaginator_Parse_Invalid(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[offset]=-10&page[limit]=ten", nil)
+
+	_, errs := jsonapi.OffsetPaginator{}.Parse(req)
+
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, "page[offset] must be an integer of at least 0", errs[0].Detail)
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "page[offset]"}, errs[0].Source)
+	assert.Equal(t, "page[limit] must be an integer of at least 1", errs[1].Detail)
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "page[limit]"}, errs[1].Source)
+}
+
+func Test_OffsetPaginator_Parse_ExceedsMaximum(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[limit]=200", nil)
+
+	_, errs := jsonapi.OffsetPaginator{MaxLimit: 100}.Parse(req)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "page[limit]"}, errs[0].Source)
+	assert.Equal(t, jsonapi.Meta{"page": jsonapi.Meta{"maxLimit": 100}}, errs[0].Meta)
+}
+
+func Test_OffsetPage_Links(t *testing.T) {
+	page := jsonapi.OffsetPage{Offset: 15, Limit: 10}
+	total := 35
+
+	links := jsonapi.TransformLinks(page.Links(jsonapi.Link{Href: "/articles"}, false, &total), "http://example.com")
+
+	assert.Equal(t, jsonapi.LinkMap{
+		jsonapi.FirstKey:    "http://example.com/articles?page[limit]=10&page[offset]=0",
+		jsonapi.PreviousKey: "http://example.com/articles?page[limit]=10&page[offset]=5",
+		jsonapi.NextKey:     "http://example.com/articles?page[limit]=10&page[offset]=25",
+		jsonapi.LastKey:     "http://example.com/articles?page[limit]=10&page[offset]=25",
+	}, links)
+}
+
+func Test_OffsetPage_Links_LastPage(t *testing.T) {
+	page := jsonapi.OffsetPage{Offset: 30, Limit: 10}
+	total := 35
+
+	links := page.Links(jsonapi.Link{Href: "/articles"}, true, &total)
+
+	assert.Equal(t, 20, links[jsonapi.PreviousKey].Queries[jsonapi.PageOffset.String()])
+	assert.Equal(t, 30, links[jsonapi.LastKey].Queries[jsonapi.PageOffset.String()])
+	assert.NotContains(t, links, jsonapi.NextKey)
+}
+
+func Test_OffsetPage_Links_LastOnGrid(t *testing.T) {
+	total := 35
+
+	tests := []struct {
+		page jsonapi.OffsetPage
+		last int
+	}{
+		{page: jsonapi.OffsetPage{Offset: 0, Limit: 10}, last: 30},
+		{page: jsonapi.OffsetPage{Offset: 3, Limit: 10}, last: 33},
+		{page: jsonapi.OffsetPage{Offset: 34, Limit: 10}, last: 34},
+		{page: jsonapi.OffsetPage{Offset: 45, Limit: 10}, last: 25},
+		{page: jsonapi.OffsetPage{Offset: 0, Limit: 50}, last: 0},
+	}
+
+	for _, test := range tests {
+		links := test.page.Links(jsonapi.Link{Href: "/articles"}, false, &total)
+		assert.Equal(t, test.last, links[jsonapi.LastKey].Queries[jsonapi.PageOffset.String()], "offset %d", test.page.Offset)
+	}
+}
+
+func Test_OffsetPage_Links_EmptyTotal(t *testing.T) {
+	page := jsonapi.OffsetPage{Offset: 0, Limit: 10}
+	total := 0
+
+	links := page.Links(jsonapi.Link{Href: "/articles"}, true, &total)
+
+	assert.Equal(t, 0, links[jsonapi.LastKey].Queries[jsonapi.PageOffset.String()])
+	assert.NotContains(t, links, jsonapi.NextKey)
+}
+
+func Test_OffsetPage_Links_WithoutTotal(t *testing.T) {
+	page := jsonapi.OffsetPage{Offset: 0, Limit: 10}
+
+	links := page.Links(jsonapi.Link{Href: "/articles"}, true, nil)
+
+	assert.Equal(t, 2, len(links))
+	assert.Equal(t, 0, links[jsonapi.FirstKey].Queries[jsonapi.PageOffset.String()])
+	assert.Equal(t, 10, links[jsonapi.NextKey].Queries[jsonapi.PageOffset.String()])
+
+	links = page.Links(jsonapi.Link{Href: "/articles"}, false, nil)
+
+	assert.Equal(t, 1, len(links))
+}
+
+func Test_OffsetPage_Meta(t *testing.T) {
+	page := jsonapi.OffsetPage{Offset: 20, Limit: 10}
+
+	assert.Equal(t, jsonapi.Meta{
+		"page": jsonapi.Meta{
+			"total":  35,
+			"offset": 20,
+			"limit":  10,
+		},
+	}, page.Meta(35))
+}
@@ -0,0 +1,183 @@
+package jsonapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+var testPaginator = jsonapi.Paginator{
+	Offset:     &jsonapi.OffsetPaginator{DefaultLimit: 10},
+	PageNumber: &jsonapi.PageNumberPaginator{FirstPage: 1, DefaultSize: 10},
+	Cursor:     &jsonapi.CursorPaginator{DefaultSize: 10},
+}
+
+func Test_Paginator_Parse_Offset(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[offset]=20&page[limit]=5", nil)
+
+	page, errs := testPaginator.Parse(req)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.OffsetStrategy, page.Strategy())
+	assert.Equal(t, 20, page.Offset())
+	assert.Equal(t, 5, page.Limit())
+}
+
+func Test_Paginator_Parse_PageNumber(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[number]=3&page[size]=5", nil)
+
+	page, errs := testPaginator.Parse(req)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.PageNumberStrategy, page.Strategy())
+	assert.Equal(t, 10, page.Offset())
+	assert.Equal(t, 5, page.Limit())
+}
+
+func Test_Paginator_Parse_Cursor(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[before]=xyz&page[size]=5", nil)
+
+	page, errs := testPaginator.Parse(req)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.CursorStrategy, page.Strategy())
+	assert.Equal(t, 0, page.Offset())
+	assert.Equal(t, 5, page.Limit())
+	assert.Equal(t, "xyz", page.Before())
+	assert.Equal(t, "", page.After())
+	assert.True(t, page.IsBackward())
+}
+
+func Test_Paginator_Parse_Default(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example", nil)
+
+	page, errs := testPaginator.Parse(req)
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.OffsetStrategy, page.Strategy())
+	assert.Equal(t, 10, page.Limit())
+
+	paginator := testPaginator
+	paginator.Default = jsonapi.CursorStrategy
+	page, errs = paginator.Parse(req)
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.CursorStrategy, page.Strategy())
+}
+
+func Test_Paginator_Parse_SizeOnly(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[size]=5", nil)
+
+	page, errs := testPaginator.Parse(req)
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.PageNumberStrategy, page.Strategy())
+	assert.Equal(t, 5, page.Limit())
+
+	page, errs = jsonapi.Paginator{Cursor: &jsonapi.CursorPaginator{}}.Parse(req)
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, jsonapi.CursorStrategy, page.Strategy())
+}
+
+func Test_Paginator_Parse_Combined(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[offset]=20&page[size]=5", nil)
+
+	_, errs := testPaginator.Parse(req)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, "page[size] cannot be combined with page[offset]", errs[0].Detail)
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "page[size]"}, errs[0].Source)
+}
+
+func Test_Paginator_Parse_Unsupported(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[after]=abc", nil)
+
+	_, errs := jsonapi.Paginator{Offset: &jsonapi.OffsetPaginator{}}.Parse(req)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "page[after]"}, errs[0].Source)
+}
+
+func Test_Paginator_Parse_PageCursor(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[cursor]=abc", nil)
+
+	_, errs := testPaginator.Parse(req)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, "Invalid Query Parameter.", errs[0].Title)
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "page[cursor]"}, errs[0].Source)
+}
+
+func Test_Paginator_Parse_Invalid(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[limit]=0", nil)
+
+	_, errs := testPaginator.Parse(req)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: "page[limit]"}, errs[0].Source)
+}
+
+func Test_Result_Offset(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[offset]=10", nil)
+	page, _ := testPaginator.Parse(req)
+
+	result := page.Result([]CursorArticle{{ArticleID: "11"}}, true)
+	links := result.Links(jsonapi.Link{Href: "/articles"})
+
+	assert.Equal(t, 3, len(links))
+	assert.Equal(t, 20, links[jsonapi.NextKey].Queries[jsonapi.PageOffset.String()])
+	assert.Nil(t, result.Meta())
+
+	total := 25
+	result.Total = &total
+
+	assert.Equal(t, 20, result.Links(jsonapi.Link{Href: "/articles"})[jsonapi.LastKey].Queries[jsonapi.PageOffset.String()])
+	assert.Equal(t, jsonapi.Meta{"page": jsonapi.Meta{"total": 25, "offset": 10, "limit": 10}}, result.Meta())
+}
+
+func Test_Result_PageNumber(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[number]=2", nil)
+	page, _ := testPaginator.Parse(req)
+
+	result := page.Result([]CursorArticle{{ArticleID: "11"}}, false)
+	links := result.Links(jsonapi.Link{Href: "/articles"})
+
+	assert.Equal(t, 2, len(links))
+	assert.Equal(t, 1, links[jsonapi.FirstKey].Queries[jsonapi.PageNumber.String()])
+	assert.Equal(t, 1, links[jsonapi.PreviousKey].Queries[jsonapi.PageNumber.String()])
+
+	result.MoreResultsAvailable = true
+	assert.Equal(t, 3, result.Links(jsonapi.Link{Href: "/articles"})[jsonapi.NextKey].Queries[jsonapi.PageNumber.String()])
+
+	total := 35
+	result.Total = &total
+
+	assert.Equal(t, 4, result.Links(jsonapi.Link{Href: "/articles"})[jsonapi.LastKey].Queries[jsonapi.PageNumber.String()])
+	assert.Equal(t, jsonapi.Meta{"page": jsonapi.Meta{"total": 35, "totalPages": 4, "number": 2, "size": 10}}, result.Meta())
+}
+
+func Test_Result_Cursor(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[after]=cursor-10", nil)
+	page, _ := testPaginator.Parse(req)
+
+	result := page.Result([]CursorArticle{{ArticleID: "11"}, {ArticleID: "12"}}, true)
+	links := jsonapi.TransformLinks(result.Links(jsonapi.Link{Href: "/articles"}), "http://example.com")
+
+	assert.Equal(t, jsonapi.LinkMap{
+		jsonapi.PreviousKey: "http://example.com/articles?page[before]=cursor-11&page[size]=10",
+		jsonapi.NextKey:     "http://example.com/articles?page[after]=cursor-12&page[size]=10",
+	}, links)
+	assert.Nil(t, result.Meta())
+}
+
+func Test_Result_Cursor_Empty(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[after]=cursor-10", nil)
+	page, _ := testPaginator.Parse(req)
+
+	links := page.Result([]CursorArticle{}, false).Links(jsonapi.Link{Href: "/articles"})
+
+	assert.Equal(t, 1, len(links))
+	assert.Equal(t, "cursor-10", links[jsonapi.PreviousKey].Queries[jsonapi.PageBefore.String()])
+}
@@ -38,25 +38,34 @@ func GetPageSize(request *http.Request) (int, error) {
 	return getQueryInteger(request, PageSize)
 }
 
-// GetPageCursor retrieves integer parsed PageOffset from query parameters
-func GetPageCursor(request *http.Request) (int, error) {
-	return getQueryInteger(request, PageCursor)
+// GetPageCursor retrieves the opaque PageCursor from query parameters
+func GetPageCursor(request *http.Request) (cursor string, exists bool) {
+	return getQueryString(request, PageCursor)
 }
 
-// GetPageBefore retrieves integer parsed PageOffset from query parameters
-func GetPageBefore(request *http.Request) (int, error) {
-	return getQueryInteger(request, PageBefore)
+// GetPageBefore retrieves the opaque PageBefore cursor from query parameters
+func GetPageBefore(request *http.Request) (cursor string, exists bool) {
+	return getQueryString(request, PageBefore)
 }
 
-// GetPageAfter retrieves integer parsed PageOffset from query parameters
-func GetPageAfter(request *http.Request) (int, error) {
-	return getQueryInteger(request, PageAfter)
+// GetPageAfter retrieves the opaque PageAfter cursor from query parameters
+func GetPageAfter(request *http.Request) (cursor string, exists bool) {
+	return getQueryString(request, PageAfter)
 }
 
 func getQueryInteger(request *http.Request, option PaginationOption) (int, error) {
 	return strconv.Atoi(request.URL.Query().Get(option.String()))
 }
 
+func getQueryString(request *http.Request, option PaginationOption) (string, bool) {
+	values, exists := request.URL.Query()[option.String()]
+	if !exists || len(values) == 0 {
+		return "", false
+	}
+
+	return values[0], true
+}
+
 // CheckUnsupportedPagination will return with an array of Errors if any unsupported pagination options are found in query parameters
 func CheckUnsupportedPagination(request *http.Request) func(unsupportedOptions ...PaginationOption) Errors {
 	return func(unsupportedOptions ...PaginationOption) (errs Errors) {
@@ -69,10 +78,10 @@ func CheckUnsupportedPagination(request *http.Request) func(unsupportedOptions .
 					Source: ErrorSource{
 						Parameter: option.String(),
 					},
-					Status: http.StatusBadRequest,
+					Status: Status(http.StatusBadRequest),
 					Links: Links{
-						"type": {
-							Href: "https://jsonapi.org/profiles/ethanresnick/cursor-pagination/#auto-id--range-pagination-not-supported-error",
+						TypeKey: {
+							Href: CursorPaginationProfile + "#auto-id--range-pagination-not-supported-error",
 						},
 					},
 				})
@@ -107,48 +116,86 @@ func CheckExceedsMaximumPaginationSize(request *http.Request) func(maxSize int)
 
 		if PageSize.QueryExists(request) {
 			if pageSize, _ := GetPageSize(request); pageSize > maxSize {
-				errs = append(errs, Error{
-					Title:  "Page size requested is too large.",
-					Detail: fmt.Sprintf("You requested a size of %d, but %d is the maximum.", pageSize, maxSize),
-					Source: ErrorSource{
-						Parameter: PageSize.String(),
-					},
-					Links: Links{
-						"type": {
-							Href: "https://jsonapi.org/profiles/ethanresnick/cursor-pagination/#auto-id--max-page-size-exceeded-error",
-						},
-					},
-					Meta: Meta{
-						"page": Meta{
-							"maxSize": maxSize,
-						},
-					},
-				})
+				errs = append(errs, pageSizeTooLargeError(pageSize, maxSize))
 			}
 		}
 
 		if PageLimit.QueryExists(request) {
 			if pageLimit, _ := GetPageLimit(request); pageLimit > maxSize {
-				errs = append(errs, Error{
-					Title:  "Page limit requested is too large.",
-					Detail: fmt.Sprintf("You requested a limit of %d, but %d is the maximum.", pageLimit, maxSize),
-					Source: ErrorSource{
-						Parameter: PageLimit.String(),
-					},
-					Links: Links{
-						"type": {
-							Href: "https://jsonapi.org/profiles/ethanresnick/cursor-pagination/#auto-id--max-page-size-exceeded-error",
-						},
-					},
-					Meta: Meta{
-						"page": Meta{
-							"maxLimit": maxSize,
-						},
-					},
-				})
+				errs = append(errs, pageLimitTooLargeError(pageLimit, maxSize))
 			}
 		}
 
 		return
 	}
 }
+
+func pageSizeTooLargeError(pageSize int, maxSize int) Error {
+	return Error{
+		Title:  "Page size requested is too large.",
+		Detail: fmt.Sprintf("You requested a size of %d, but %d is the maximum.", pageSize, maxSize),
+		Source: ErrorSource{
+			Parameter: PageSize.String(),
+		},
+		Status: Status(http.StatusBadRequest),
+		Links: Links{
+			TypeKey: {
+				Href: CursorPaginationProfile + "#auto-id--max-page-size-exceeded-error",
+			},
+		},
+		Meta: Meta{
+			"page": Meta{
+				"maxSize": maxSize,
+			},
+		},
+	}
+}
+
+func pageLimitTooLargeError(pageLimit int, maxLimit int) Error {
+	return Error{
+		Title:  "Page limit requested is too large.",
+		Detail: fmt.Sprintf("You requested a limit of %d, but %d is the maximum.", pageLimit, maxLimit),
+		Source: ErrorSource{
+			Parameter: PageLimit.String(),
+		},
+		Status: Status(http.StatusBadRequest),
+		Links: Links{
+			TypeKey: {
+				Href: CursorPaginationProfile + "#auto-id--max-page-size-exceeded-error",
+			},
+		},
+		Meta: Meta{
+			"page": Meta{
+				"maxLimit": maxLimit,
+			},
+		},
+	}
+}
+
+// getPageInteger parses the pagination option as an integer of at least the minimum, the fallback is used when the option is not requested
+func getPageInteger(request *http.Request, option PaginationOption, minimum int, fallback int) (int, Errors) {
+	if !option.QueryExists(request) {
+		return fallback, nil
+	}
+
+	value, err := getQueryInteger(request, option)
+	if err != nil || value < minimum {
+		return fallback, Errors{InvalidQueryParameter(option.String(), fmt.Sprintf("%s must be an integer of at least %d", option, minimum))}
+	}
+
+	return value, nil
+}
+
+// paginationLink copies the provided link with the pagination queries added to its own
+func paginationLink(link Link, queries Queries) Link {
+	paginated := make(Queries, len(link.Queries)+len(queries))
+	for key, value := range link.Queries {
+		paginated[key] = value
+	}
+	for key, value := range queries {
+		paginated[key] = value
+	}
+	link.Queries = paginated
+
+	return link
+}
@@ -117,84 +117,84 @@ func Test_GetPageSize_ParsingError(t *testing.T) {
 }
 
 func Test_GetPageCursor(t *testing.T) {
-	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[cursor]=10", nil)
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[cursor]=eyJpZCI6MTB9", nil)
 
-	cursor, err := jsonapi.GetPageCursor(req)
+	cursor, exists := jsonapi.GetPageCursor(req)
 
-	assert.Nil(t, err)
-	assert.Equal(t, 10, cursor)
+	assert.True(t, exists)
+	assert.Equal(t, "eyJpZCI6MTB9", cursor)
 }
 
 func Test_GetPageCursor_Missing(t *testing.T) {
 	req := httptest.NewRequest("GET", "http://localhost:8080/example", nil)
 
-	cursor, err := jsonapi.GetPageCursor(req)
+	cursor, exists := jsonapi.GetPageCursor(req)
 
-	assert.NotNil(t, err)
-	assert.Equal(t, 0, cursor)
+	assert.False(t, exists)
+	assert.Equal(t, "", cursor)
 }
 
-func Test_GetPageCursor_ParsingError(t *testing.T) {
-	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[cursor]=ten", nil)
+func Test_GetPageCursor_Empty(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[cursor]=", nil)
 
-	cursor, err := jsonapi.GetPageCursor(req)
+	cursor, exists := jsonapi.GetPageCursor(req)
 
-	assert.NotNil(t, err)
-	assert.Equal(t, 0, cursor)
+	assert.True(t, exists)
+	assert.Equal(t, "", cursor)
 }
 
 func Test_GetPageBefore(t *testing.T) {
-	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[before]=10", nil)
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[before]=eyJpZCI6MTB9", nil)
 
-	before, err := jsonapi.GetPageBefore(req)
+	before, exists := jsonapi.GetPageBefore(req)
 
-	assert.Nil(t, err)
-	assert.Equal(t, 10, before)
+	assert.True(t, exists)
+	assert.Equal(t, "eyJpZCI6MTB9", before)
 }
 
 func Test_GetPageBefore_Missing(t *testing.T) {
 	req := httptest.NewRequest("GET", "http://localhost:8080/example", nil)
 
-	before, err := jsonapi.GetPageBefore(req)
+	before, exists := jsonapi.GetPageBefore(req)
 
-	assert.NotNil(t, err)
-	assert.Equal(t, 0, before)
+	assert.False(t, exists)
+	assert.Equal(t, "", before)
 }
 
-func Test_GetPageBefore_ParsingError(t *testing.T) {
-	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[before]=ten", nil)
+func Test_GetPageBefore_Empty(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[before]=", nil)
 
-	before, err := jsonapi.GetPageBefore(req)
+	before, exists := jsonapi.GetPageBefore(req)
 
-	assert.NotNil(t, err)
-	assert.Equal(t, 0, before)
+	assert.True(t, exists)
+	assert.Equal(t, "", before)
 }
 
 func Test_GetPageAfter(t *testing.T) {
-	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[after]=10", nil)
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[after]=eyJpZCI6MTB9", nil)
 
-	after, err := jsonapi.GetPageAfter(req)
+	after, exists := jsonapi.GetPageAfter(req)
 
-	assert.Nil(t, err)
-	assert.Equal(t, 10, after)
+	assert.True(t, exists)
+	assert.Equal(t, "eyJpZCI6MTB9", after)
 }
 
 func Test_GetPageAfter_Missing(t *testing.T) {
 	req := httptest.NewRequest("GET", "http://localhost:8080/example", nil)
 
-	after, err := jsonapi.GetPageAfter(req)
+	after, exists := jsonapi.GetPageAfter(req)
 
-	assert.NotNil(t, err)
-	assert.Equal(t, 0, after)
+	assert.False(t, exists)
+	assert.Equal(t, "", after)
 }
 
-func Test_GetPageAfter_ParsingError(t *testing.T) {
-	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[after]=ten", nil)
+func Test_GetPageAfter_Empty(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?page[after]=", nil)
 
-	after, err := jsonapi.GetPageAfter(req)
+	after, exists := jsonapi.GetPageAfter(req)
 
-	assert.NotNil(t, err)
-	assert.Equal(t, 0, after)
+	assert.True(t, exists)
+	assert.Equal(t, "", after)
 }
 
 func Test_CheckUnsupportedPagination(t *testing.T) {
@@ -0,0 +1,160 @@
+package jsonapi
+
+import (
+	"reflect"
+	"strings"
+)
+
+// dataPointer is the JSON pointer to the primary data of a request document
+const dataPointer string = "/data"
+
+// ResourcePointer resolves a path of Go struct field names (and slice indexes or map keys) of the provided resource into a JSON pointer
+// to the member of the request document, ex. ResourcePointer(person, "Address", "City") => /data/attributes/address/city
+//
+// jsonapi struct tags are used to tell the id, attributes and relationships apart. Otherwise, the json tag names of the struct fields are used
+// and fields named after a key of Relationships() point to relationships. The pointer falls back to /data for fields that are not serialized.
+func ResourcePointer(node interface{}, path ...string) string {
+	structType := indirectType(reflect.TypeOf(node))
+	if structType == nil || structType.Kind() != reflect.Struct || len(path) == 0 {
+		return dataPointer
+	}
+
+	field, exists := structType.FieldByName(path[0])
+	if !exists {
+		return dataPointer
+	}
+
+	if metadata := getTagMetadata(structType); metadata != nil {
+		return metadata.pointer(field, path[1:])
+	}
+
+	name, isSerialized := jsonFieldName(field)
+	if !isSerialized {
+		return dataPointer
+	}
+
+	if field.Anonymous && len(field.Tag.Get("json")) == 0 {
+		return jsonPointer(dataPointer+"/attributes", field.Type, path[1:])
+	}
+
+	if isRelationshipName(node, name) {
+		return dataPointer + "/relationships/" + escapePointer(name)
+	}
+
+	return jsonPointer(dataPointer+"/attributes/"+escapePointer(name), field.Type, path[1:])
+}
+
+func (metadata *tagMetadata) pointer(field reflect.StructField, path []string) string {
+	switch {
+	case equalIndex(field.Index, metadata.primary):
+		return dataPointer + "/id"
+	case equalIndex(field.Index, metadata.meta):
+		return jsonPointer(dataPointer+"/meta", field.Type, path)
+	}
+
+	for _, attribute := range metadata.attributes {
+		if equalIndex(field.Index, attribute.index) {
+			return jsonPointer(dataPointer+"/attributes/"+escapePointer(attribute.name), field.Type, path)
+		}
+	}
+
+	for _, relationship := range metadata.relationships {
+		if equalIndex(field.Index, relationship.index) {
+			return dataPointer + "/relationships/" + escapePointer(relationship.name)
+		}
+	}
+
+	return dataPointer
+}
+
+// jsonPointer appends the json names of the path within the provided type to the pointer
+func jsonPointer(pointer string, valueType reflect.Type, path []string) string {
+	for _, segment := range path {
+		valueType = indirectType(valueType)
+
+		switch valueType.Kind() {
+		case reflect.Struct:
+			field, exists := valueType.FieldByName(segment)
+			if !exists {
+				return pointer
+			}
+
+			name, isSerialized := jsonFieldName(field)
+			if !isSerialized {
+				return pointer
+			}
+
+			// embedded structs without a json name are flattened into the parent object
+			if !field.Anonymous || len(field.Tag.Get("json")) > 0 {
+				pointer += "/" + escapePointer(name)
+			}
+			valueType = field.Type
+
+		case reflect.Slice, reflect.Array, reflect.Map:
+			pointer += "/" + escapePointer(segment)
+			valueType = valueType.Elem()
+
+		default:
+			return pointer
+		}
+	}
+
+	return pointer
+}
+
+// jsonFieldName returns the name of the struct field as serialized by encoding/json
+func jsonFieldName(field reflect.StructField) (name string, isSerialized bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" || len(field.PkgPath) > 0 && !field.Anonymous {
+		return "", false
+	}
+
+	name = strings.Split(tag, ",")[0]
+	if len(name) == 0 {
+		name = field.Name
+	}
+
+	return name, true
+}
+
+func isRelationshipName(node interface{}, name string) bool {
+	value := reflect.ValueOf(node)
+	if value.Kind() == reflect.Ptr && value.IsNil() {
+		return false
+	}
+
+	relationshipNode, isRelationshipable := node.(Relationshipable)
+	if !isRelationshipable {
+		return false
+	}
+
+	_, exists := relationshipNode.Relationships()[name]
+	return exists
+}
+
+func indirectType(valueType reflect.Type) reflect.Type {
+	for valueType != nil && valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+
+	return valueType
+}
+
+func equalIndex(a []int, b []int) bool {
+	if len(a) != len(b) || a == nil {
+		return false
+	}
+
+	for x := range a {
+		if a[x] != b[x] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// escapePointer escapes a reference token of a JSON pointer: https://datatracker.ietf.org/doc/html/rfc6901#section-3
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
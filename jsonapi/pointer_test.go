@@ -0,0 +1,82 @@
+package jsonapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type pointerAddress struct {
+	City   string `json:"city"`
+	Street string `json:"-"`
+}
+
+type pointerBase struct {
+	CreatedBy string `json:"createdBy"`
+}
+
+type pointerPerson struct {
+	pointerBase
+	PersonID  string            `json:"-"`
+	FirstName string            `json:"firstName,omitempty"`
+	Address   pointerAddress    `json:"address"`
+	Tags      []string          `json:"tags"`
+	Labels    map[string]string `json:"labels"`
+	Nickname  string
+	Company   string `json:"company"`
+}
+
+func (person pointerPerson) ID() string {
+	return person.PersonID
+}
+
+func (person pointerPerson) Type() string {
+	return "people"
+}
+
+func (person pointerPerson) Relationships() map[string]interface{} {
+	return map[string]interface{}{
+		"company": person.Company,
+	}
+}
+
+func Test_ResourcePointer(t *testing.T) {
+	person := pointerPerson{}
+
+	assert.Equal(t, "/data/attributes/firstName", ResourcePointer(person, "FirstName"))
+	assert.Equal(t, "/data/attributes/Nickname", ResourcePointer(&person, "Nickname"))
+	assert.Equal(t, "/data/attributes/address/city", ResourcePointer(person, "Address", "City"))
+	assert.Equal(t, "/data/attributes/address", ResourcePointer(person, "Address", "Street"))
+	assert.Equal(t, "/data/attributes/tags/1", ResourcePointer(person, "Tags", "1"))
+	assert.Equal(t, "/data/attributes/labels/a~1b", ResourcePointer(person, "Labels", "a/b"))
+	assert.Equal(t, "/data/attributes/createdBy", ResourcePointer(person, "pointerBase", "CreatedBy"))
+	assert.Equal(t, "/data/relationships/company", ResourcePointer(person, "Company"))
+	assert.Equal(t, "/data", ResourcePointer(person, "PersonID"))
+	assert.Equal(t, "/data", ResourcePointer(person, "Unknown"))
+	assert.Equal(t, "/data", ResourcePointer(person))
+}
+
+func Test_ResourcePointer_NilPtr(t *testing.T) {
+	var person *pointerPerson
+
+	assert.Equal(t, "/data/attributes/company", ResourcePointer(person, "Company"))
+}
+
+func Test_ResourcePointer_Tagged(t *testing.T) {
+	assert.Equal(t, "/data/id", ResourcePointer(taggedPerson{}, "PersonID"))
+	assert.Equal(t, "/data/attributes/firstName", ResourcePointer(taggedPerson{}, "FirstName"))
+	assert.Equal(t, "/data/attributes/Age", ResourcePointer(&taggedPerson{}, "Age"))
+	assert.Equal(t, "/data/relationships/company", ResourcePointer(taggedPerson{}, "Company", "Name"))
+	assert.Equal(t, "/data/relationships/previous", ResourcePointer(taggedPerson{}, "Previous", "0"))
+	assert.Equal(t, "/data/meta/something", ResourcePointer(taggedPerson{}, "Extra", "something"))
+	assert.Equal(t, "/data", ResourcePointer(taggedPerson{}, "Ignored"))
+}
+
+func Test_ResourcePointer_NotStruct(t *testing.T) {
+	assert.Equal(t, "/data", ResourcePointer("person", "FirstName"))
+	assert.Equal(t, "/data", ResourcePointer(nil, "FirstName"))
+}
+
+func Test_escapePointer(t *testing.T) {
+	assert.Equal(t, "a~0b~1c", escapePointer("a~b/c"))
+}
@@ -1,6 +1,10 @@
 package jsonapi
 
-import "reflect"
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
 
 type internalResourceIdentifier struct {
 	ID   string      `json:"id"`
@@ -22,6 +26,48 @@ type Relationshipable interface {
 	Relationships() map[string]interface{} // Node | []Node
 }
 
+// ResourceIdentifier is the standard JSONAPI Resource Identifier object decoded from relationship linkage
+type ResourceIdentifier struct {
+	ID   string      `json:"id"`
+	Type string      `json:"type"`
+	Meta interface{} `json:"meta,omitempty"`
+}
+
+// RelationshipLinkage is the linkage of a relationship decoded from a request document.
+// To-one linkage holds a single identifier (or none when null), to-many linkage holds every identifier.
+type RelationshipLinkage struct {
+	ToMany bool // data is an array of resource identifiers
+	Data   []ResourceIdentifier
+}
+
+// One returns the identifier of to-one linkage (nil when null), or an ErrRelationshipShape error for to-many linkage
+func (linkage RelationshipLinkage) One() (*ResourceIdentifier, error) {
+	if linkage.ToMany {
+		return nil, fmt.Errorf("%w, data must be a resource identifier object or null", ErrRelationshipShape)
+	}
+
+	if len(linkage.Data) == 0 {
+		return nil, nil
+	}
+
+	return &linkage.Data[0], nil
+}
+
+// Many returns the identifiers of to-many linkage, or an ErrRelationshipShape error for to-one linkage
+func (linkage RelationshipLinkage) Many() ([]ResourceIdentifier, error) {
+	if !linkage.ToMany {
+		return nil, fmt.Errorf("%w, data must be an array of resource identifier objects", ErrRelationshipShape)
+	}
+
+	return linkage.Data, nil
+}
+
+// RelationshipSettable method for binding relationship linkage decoded from a request document.
+// Returning an ErrRelationshipShape error reports a 400 Bad Request pointing at the data of the relationship.
+type RelationshipSettable interface {
+	SetRelationship(name string, linkage RelationshipLinkage) error
+}
+
 func transformRelationships(node Node, baseURL string) (map[string]internalRelationship, []Node) {
 	if relationshipNode, isRelationshipable := node.(Relationshipable); isRelationshipable {
 
@@ -30,8 +76,15 @@ func transformRelationships(node Node, baseURL string) (map[string]internalRelat
 		internalRelationships := make(map[string]internalRelationship)
 		included := make([]Node, 0)
 
-		for k, v := range relationships {
-			relationship, inc := transformRelationship(v, node.ID(), baseURL)
+		// iterate in sorted order so that included nodes are gathered deterministically
+		names := make([]string, 0, len(relationships))
+		for name := range relationships {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, k := range names {
+			relationship, inc := transformRelationship(relationships[k], node.ID(), baseURL)
 			internalRelationships[k] = relationship
 			included = append(included, inc...)
 		}
@@ -76,7 +129,7 @@ func transformRelationNodes(r interface{}) (interface{}, []Node) {
 		internalResources := make([]internalResourceIdentifier, 0)
 		included := make([]Node, 0)
 		for x := 0; x < vals.Len(); x++ {
-			if node, isNodeable := vals.Index(x).Interface().(Node); isNodeable {
+			if node, isNodeable := asNode(vals.Index(x).Interface()); isNodeable {
 				internalResources = append(internalResources, createResourceIdentifier(node))
 				included = append(included, node)
 			}
@@ -84,11 +137,14 @@ func transformRelationNodes(r interface{}) (interface{}, []Node) {
 		return internalResources, included
 
 	case reflect.Struct:
-		if node, isNodeable := vals.Interface().(Node); isNodeable {
+		if node, isNodeable := asNode(vals.Interface()); isNodeable {
 			return createResourceIdentifier(node), []Node{node}
 		}
 
 	case reflect.Ptr:
+		if vals.IsNil() {
+			return nil, nil
+		}
 		return transformRelationNodes(reflect.Indirect(vals).Interface())
 	}
 
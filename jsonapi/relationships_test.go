@@ -1,6 +1,7 @@
 package jsonapi
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -32,8 +33,8 @@ func Test_transformRelationships_Relationshipable(t *testing.T) {
 
 	assert.NotNil(t, included)
 	assert.Equal(t, 2, len(included))
-	assert.Equal(t, testObject.TestData[0], included[0])
-	assert.Equal(t, testObject.SingleTest, included[1])
+	assert.Equal(t, testObject.SingleTest, included[0])
+	assert.Equal(t, testObject.TestData[0], included[1])
 }
 
 func Test_transformRelationships_Nil(t *testing.T) {
@@ -229,3 +230,25 @@ func Test_createResourceIdentifier_Meta(t *testing.T) {
 	assert.NotNil(t, resource.Meta)
 	assert.Equal(t, testObject.Meta(), resource.Meta)
 }
+
+func Test_RelationshipLinkage_One(t *testing.T) {
+	id, err := RelationshipLinkage{Data: []ResourceIdentifier{{ID: "1", Type: "people"}}}.One()
+	assert.NoError(t, err)
+	assert.Equal(t, &ResourceIdentifier{ID: "1", Type: "people"}, id)
+
+	id, err = RelationshipLinkage{Data: []ResourceIdentifier{}}.One()
+	assert.NoError(t, err)
+	assert.Nil(t, id)
+
+	_, err = RelationshipLinkage{ToMany: true}.One()
+	assert.True(t, errors.Is(err, ErrRelationshipShape))
+}
+
+func Test_RelationshipLinkage_Many(t *testing.T) {
+	ids, err := RelationshipLinkage{ToMany: true, Data: []ResourceIdentifier{{ID: "1", Type: "comments"}}}.Many()
+	assert.NoError(t, err)
+	assert.Equal(t, []ResourceIdentifier{{ID: "1", Type: "comments"}}, ids)
+
+	_, err = RelationshipLinkage{Data: []ResourceIdentifier{}}.Many()
+	assert.True(t, errors.Is(err, ErrRelationshipShape))
+}
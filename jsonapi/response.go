@@ -3,7 +3,6 @@ package jsonapi
 import (
 	"fmt"
 	"net/http"
-	"net/url"
 	"strings"
 )
 
@@ -13,6 +12,15 @@ func CreateResponse(request *http.Request) func(r Response) TransformedResponse
 		baseURL, path := CreateBaseURL(request)
 		r.Links = AppendGeneratedSelfLink(request)(r.Links, baseURL, path)
 
+		if r.Fields == nil {
+			r.Fields = GetFields(request)
+		}
+
+		// only include resources requested by the include query parameter, an empty Included will include nothing
+		if r.Include == nil && !r.IncludeAll {
+			r.Include = append(Included{}, GetIncluded(request)...)
+		}
+
 		return TransformResponse(r, baseURL)
 	}
 }
@@ -23,6 +31,15 @@ func CreateCollectionResponse(request *http.Request) func(r CollectionResponse)
 		baseURL, path := CreateBaseURL(request)
 		r.Links = AppendGeneratedSelfLink(request)(r.Links, baseURL, path)
 
+		if r.Fields == nil {
+			r.Fields = GetFields(request)
+		}
+
+		// only include resources requested by the include query parameter, an empty Included will include nothing
+		if r.Include == nil && !r.IncludeAll {
+			r.Include = append(Included{}, GetIncluded(request)...)
+		}
+
 		return TransformCollectionResponse(r, baseURL)
 	}
 }
@@ -39,8 +56,7 @@ func AppendGeneratedSelfLink(request *http.Request) func(links Links, baseURL st
 
 		queryValues := request.URL.Query()
 		if queryValues != nil && len(queryValues) > 0 {
-			query, _ := url.QueryUnescape(queryValues.Encode())
-			href += fmt.Sprintf("?%s", query)
+			href += fmt.Sprintf("?%s", encodeQuery(queryValues))
 		}
 
 		if links == nil {
@@ -1,6 +1,7 @@
 package jsonapi_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -100,3 +101,90 @@ func Test_createBaseURL_NoScheme(t *testing.T) {
 	assert.Equal(t, "http://localhost:8080", baseURL)
 	assert.Equal(t, "/example", path)
 }
+
+func Test_CreateResponse_Include(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/articles/1?include=author", nil)
+
+	response := jsonapi.CreateResponse(req)(jsonapi.Response{
+		Node: includeArticle,
+	})
+
+	assert.Equal(t, 1, len(response.Included))
+	assert.Equal(t, 0, len(response.Errors))
+
+	got, err := json.Marshal(response.Included)
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[
+		{
+			"id": "9",
+			"type": "people",
+			"attributes": {"name": "Dan"},
+			"relationships": {
+				"company": {"data": {"id": "3", "type": "companies"}}
+			}
+		}
+	]`, string(got))
+}
+
+func Test_CreateResponse_Include_Missing(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/articles/1", nil)
+
+	response := jsonapi.CreateResponse(req)(jsonapi.Response{
+		Node: includeArticle,
+	})
+
+	assert.NotNil(t, response.Data)
+	assert.Nil(t, response.Included)
+}
+
+func Test_CreateResponse_IncludeAll(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/articles/1?include=author", nil)
+
+	response := jsonapi.CreateResponse(req)(jsonapi.Response{
+		Node:       includeArticle,
+		IncludeAll: true,
+	})
+
+	assert.Equal(t, 2, len(response.Included))
+}
+
+func Test_CreateResponse_Include_Unknown(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/articles/1?include=author.unknown,editor", nil)
+
+	response := jsonapi.CreateResponse(req)(jsonapi.Response{
+		Node: includeArticle,
+	})
+
+	assert.Nil(t, response.Data)
+	assert.Nil(t, response.Included)
+
+	got, err := json.Marshal(response.Errors)
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[
+		{
+			"status": "400",
+			"title": "Invalid Query Parameter.",
+			"detail": "author.unknown is not a known relationship path",
+			"source": {"parameter": "include"}
+		},
+		{
+			"status": "400",
+			"title": "Invalid Query Parameter.",
+			"detail": "editor is not a known relationship path",
+			"source": {"parameter": "include"}
+		}
+	]`, string(got))
+}
+
+func Test_CreateCollectionResponse_Include_OmittedRelationship(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/articles?include=author.company", nil)
+
+	response := jsonapi.CreateCollectionResponse(req)(jsonapi.CollectionResponse{
+		Nodes: []IncludeArticle{{ArticleID: "2"}},
+	})
+
+	assert.Equal(t, 0, len(response.Errors))
+	assert.Nil(t, response.Included)
+}
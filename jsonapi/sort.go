@@ -0,0 +1,85 @@
+package jsonapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SortField represents a single field of the sort query parameter
+type SortField struct {
+	Field      string
+	Descending bool
+}
+
+// String returns the sort field in query parameter format, prefixed with a minus for descending order
+func (field SortField) String() string {
+	if field.Descending {
+		return "-" + field.Field
+	}
+	return field.Field
+}
+
+// Sort is the ordered array of SortField items representing the sort query parameter
+type Sort []SortField
+
+// GetSort extracts ordered sort fields from request query parameters
+func GetSort(request *http.Request) (sort Sort) {
+	sortQuery := request.URL.Query().Get(SortParameter)
+
+	if len(sortQuery) == 0 {
+		return
+	}
+
+	for _, field := range strings.Split(sortQuery, ",") {
+		descending := strings.HasPrefix(field, "-")
+		field = strings.TrimPrefix(field, "-")
+
+		if len(field) == 0 {
+			continue
+		}
+
+		sort = append(sort, SortField{
+			Field:      field,
+			Descending: descending,
+		})
+	}
+
+	return
+}
+
+// String returns the sort fields in query parameter format
+func (sort Sort) String() string {
+	fields := make([]string, 0, len(sort))
+	for _, field := range sort {
+		fields = append(fields, field.String())
+	}
+	return strings.Join(fields, ",")
+}
+
+// HasField will check the provided sort array for the requested field name
+func (sort Sort) HasField(field string) bool {
+	for _, sortField := range sort {
+		if sortField.Field == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// VerifyFields will return with an array of Errors if any requested sort field is not in the provided, supported fields
+func (sort Sort) VerifyFields(fields ...string) (errs Errors) {
+	supported := make(map[string]bool)
+	for _, field := range fields {
+		supported[field] = true
+	}
+
+	for _, sortField := range sort {
+		if !supported[sortField.Field] {
+			errs = append(errs, InvalidQueryParameter(SortParameter, fmt.Sprintf("%s is not a supported sort field", sortField.Field)))
+		}
+	}
+
+	return
+}
@@ -0,0 +1,64 @@
+package jsonapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetSort(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example?sort=-createdAt,name,,-", nil)
+
+	sort := jsonapi.GetSort(req)
+
+	assert.Equal(t, jsonapi.Sort{
+		{Field: "createdAt", Descending: true},
+		{Field: "name"},
+	}, sort)
+}
+
+func Test_GetSort_Missing(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://localhost:8080/example", nil)
+
+	sort := jsonapi.GetSort(req)
+
+	assert.Equal(t, 0, len(sort))
+}
+
+func Test_Sort_String(t *testing.T) {
+	sort := jsonapi.Sort{
+		{Field: "createdAt", Descending: true},
+		{Field: "name"},
+	}
+
+	assert.Equal(t, "-createdAt,name", sort.String())
+}
+
+func Test_Sort_HasField(t *testing.T) {
+	sort := jsonapi.Sort{{Field: "name"}}
+
+	assert.True(t, sort.HasField("name"))
+	assert.False(t, sort.HasField("age"))
+}
+
+func Test_Sort_VerifyFields_OK(t *testing.T) {
+	sort := jsonapi.Sort{{Field: "createdAt", Descending: true}, {Field: "name"}}
+
+	errs := sort.VerifyFields("name", "createdAt")
+
+	assert.False(t, errs.HasErrors())
+}
+
+func Test_Sort_VerifyFields_Unsupported(t *testing.T) {
+	sort := jsonapi.Sort{{Field: "createdAt", Descending: true}, {Field: "age"}}
+
+	errs := sort.VerifyFields("name", "createdAt")
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, "age is not a supported sort field", errs[0].Detail)
+	assert.Equal(t, jsonapi.ErrorSource{Parameter: jsonapi.SortParameter}, errs[0].Source)
+}
@@ -0,0 +1,226 @@
+package jsonapi
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Struct tag annotations used as an alternative to implementing the Node interface methods.
+//
+//	type Person struct {
+//		PersonID  string        `jsonapi:"primary,people"`
+//		FirstName string        `jsonapi:"attr,firstName"`
+//		Company   *Company      `jsonapi:"relation,company,omitempty"`
+//		Meta      jsonapi.Meta  `jsonapi:"meta"`
+//		Links     jsonapi.Links `jsonapi:"links"`
+//	}
+const (
+	tagKey          string = "jsonapi"
+	tagPrimary      string = "primary"
+	tagAttribute    string = "attr"
+	tagRelationship string = "relation"
+	tagMeta         string = "meta"
+	tagLinks        string = "links"
+	tagOmitEmpty    string = "omitempty"
+)
+
+type tagField struct {
+	index     []int
+	name      string
+	omitEmpty bool
+}
+
+type tagMetadata struct {
+	resourceType  string
+	primary       []int
+	attributes    []tagField
+	relationships []tagField
+	meta          []int
+	links         []int
+}
+
+// tagMetadataCache stores the parsed *tagMetadata of each reflect.Type, nil when the type has no primary tag.
+var tagMetadataCache sync.Map
+
+func getTagMetadata(structType reflect.Type) *tagMetadata {
+	if cached, exists := tagMetadataCache.Load(structType); exists {
+		return cached.(*tagMetadata)
+	}
+
+	metadata := parseTagMetadata(structType)
+	tagMetadataCache.Store(structType, metadata)
+
+	return metadata
+}
+
+func parseTagMetadata(structType reflect.Type) *tagMetadata {
+	metadata := new(tagMetadata)
+
+	for x := 0; x < structType.NumField(); x++ {
+		field := structType.Field(x)
+
+		tag, hasTag := field.Tag.Lookup(tagKey)
+		if !hasTag || len(field.PkgPath) > 0 {
+			continue
+		}
+
+		options := strings.Split(tag, ",")
+		switch options[0] {
+		case tagPrimary:
+			if len(options) > 1 {
+				metadata.resourceType = options[1]
+			}
+			metadata.primary = field.Index
+
+		case tagAttribute:
+			metadata.attributes = append(metadata.attributes, parseTagField(field, options))
+
+		case tagRelationship:
+			metadata.relationships = append(metadata.relationships, parseTagField(field, options))
+
+		case tagMeta:
+			metadata.meta = field.Index
+
+		case tagLinks:
+			metadata.links = field.Index
+		}
+	}
+
+	if metadata.primary == nil {
+		return nil
+	}
+
+	return metadata
+}
+
+func parseTagField(field reflect.StructField, options []string) tagField {
+	tagged := tagField{
+		index: field.Index,
+		name:  field.Name,
+	}
+
+	if len(options) > 1 && len(options[1]) > 0 {
+		tagged.name = options[1]
+	}
+
+	for x := 2; x < len(options); x++ {
+		if options[x] == tagOmitEmpty {
+			tagged.omitEmpty = true
+		}
+	}
+
+	return tagged
+}
+
+// taggedNode implements Node and the optional Node methods for structs annotated with jsonapi struct tags
+type taggedNode struct {
+	value    reflect.Value
+	metadata *tagMetadata
+}
+
+func asNode(payload interface{}) (Node, bool) {
+	if node, isNode := payload.(Node); isNode {
+		return node, true
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(payload))
+	if value.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	metadata := getTagMetadata(value.Type())
+	if metadata == nil {
+		return nil, false
+	}
+
+	return taggedNode{value: value, metadata: metadata}, true
+}
+
+func (node taggedNode) ID() string {
+	return fmt.Sprintf("%v", node.value.FieldByIndex(node.metadata.primary).Interface())
+}
+
+func (node taggedNode) Type() string {
+	return node.metadata.resourceType
+}
+
+func (node taggedNode) Attributes() interface{} {
+	attributes := node.fieldMap(node.metadata.attributes)
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	return attributes
+}
+
+func (node taggedNode) Relationships() map[string]interface{} {
+	return node.fieldMap(node.metadata.relationships)
+}
+
+// hasRelationship checks if the relationship is declared by the struct tags, regardless of omitempty
+func (node taggedNode) hasRelationship(name string) bool {
+	for _, field := range node.metadata.relationships {
+		if field.name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (node taggedNode) Meta() interface{} {
+	if node.metadata.meta == nil {
+		return nil
+	}
+
+	meta := node.value.FieldByIndex(node.metadata.meta)
+	if isEmptyValue(meta) {
+		return nil
+	}
+
+	return meta.Interface()
+}
+
+func (node taggedNode) Links() Links {
+	if node.metadata.links == nil {
+		return nil
+	}
+
+	links, _ := node.value.FieldByIndex(node.metadata.links).Interface().(Links)
+	return links
+}
+
+func (node taggedNode) fieldMap(fields []tagField) map[string]interface{} {
+	values := make(map[string]interface{})
+
+	for _, field := range fields {
+		value := node.value.FieldByIndex(field.index)
+		if field.omitEmpty && isEmptyValue(value) {
+			continue
+		}
+		values[field.name] = value.Interface()
+	}
+
+	return values
+}
+
+// isEmptyValue mirrors the omitempty rules of encoding/json
+func isEmptyValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Bool:
+		return !value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return value.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return value.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return value.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package jsonapi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type taggedCompany struct {
+	CompanyID int    `jsonapi:"primary,companies"`
+	Name      string `jsonapi:"attr,name"`
+}
+
+type taggedPerson struct {
+	PersonID  string          `jsonapi:"primary,people"`
+	FirstName string          `jsonapi:"attr,firstName"`
+	Nickname  string          `jsonapi:"attr,nickname,omitempty"`
+	Age       int             `jsonapi:"attr"`
+	Company   *taggedCompany  `jsonapi:"relation,company"`
+	Previous  []taggedCompany `jsonapi:"relation,previous,omitempty"`
+	Extra     Meta            `jsonapi:"meta"`
+	Linked    Links           `jsonapi:"links"`
+	Ignored   string
+}
+
+var testTagged = taggedPerson{
+	PersonID:  "1234",
+	FirstName: "John",
+	Age:       30,
+	Company: &taggedCompany{
+		CompanyID: 9876,
+		Name:      "Example",
+	},
+	Extra: Meta{
+		"something": "interesting",
+	},
+	Linked: Links{
+		SelfKey: Link{
+			Href: "/people/1234",
+		},
+	},
+}
+
+func Test_getTagMetadata(t *testing.T) {
+	metadata := getTagMetadata(reflect.TypeOf(taggedPerson{}))
+
+	assert.NotNil(t, metadata)
+	assert.Equal(t, "people", metadata.resourceType)
+	assert.Equal(t, []int{0}, metadata.primary)
+	assert.Equal(t, []tagField{
+		{index: []int{1}, name: "firstName"},
+		{index: []int{2}, name: "nickname", omitEmpty: true},
+		{index: []int{3}, name: "Age"},
+	}, metadata.attributes)
+	assert.Equal(t, []tagField{
+		{index: []int{4}, name: "company"},
+		{index: []int{5}, name: "previous", omitEmpty: true},
+	}, metadata.relationships)
+	assert.Equal(t, []int{6}, metadata.meta)
+	assert.Equal(t, []int{7}, metadata.links)
+
+	assert.Same(t, metadata, getTagMetadata(reflect.TypeOf(taggedPerson{})))
+}
+
+func Test_getTagMetadata_Untagged(t *testing.T) {
+	metadata := getTagMetadata(reflect.TypeOf(struct{ Name string }{}))
+
+	assert.Nil(t, metadata)
+}
+
+func Test_asNode_Node(t *testing.T) {
+	node, isNode := asNode(testObject)
+
+	assert.True(t, isNode)
+	assert.Equal(t, testObject, node)
+}
+
+func Test_asNode_Tagged(t *testing.T) {
+	node, isNode := asNode(&testTagged)
+
+	assert.True(t, isNode)
+	assert.Equal(t, "1234", node.ID())
+	assert.Equal(t, "people", node.Type())
+}
+
+func Test_asNode_Untagged(t *testing.T) {
+	node, isNode := asNode("testObject")
+
+	assert.False(t, isNode)
+	assert.Nil(t, node)
+}
+
+func Test_asNode_NilPtr(t *testing.T) {
+	var person *taggedPerson
+	node, isNode := asNode(person)
+
+	assert.False(t, isNode)
+	assert.Nil(t, node)
+}
+
+func Test_transformNode_Tagged(t *testing.T) {
+	transformed, included := transformNode(testTagged, baseURL)
+
+	assert.Equal(t, "1234", transformed.ID)
+	assert.Equal(t, "people", transformed.Type)
+	assert.Equal(t, map[string]interface{}{"firstName": "John", "Age": 30}, transformed.Attributes)
+	assert.Equal(t, testTagged.Extra, transformed.Meta)
+	assert.Equal(t, baseURL+"/people/1234", transformed.Links[SelfKey])
+
+	assert.Equal(t, 1, len(transformed.Relationships))
+	assert.Equal(t, internalResourceIdentifier{ID: "9876", Type: "companies"}, transformed.Relationships["company"].Data)
+
+	assert.Equal(t, 1, len(included))
+	assert.Equal(t, "9876", included[0].ID())
+	assert.Equal(t, "companies", included[0].Type())
+	assert.Equal(t, map[string]interface{}{"name": "Example"}, included[0].(Attributeable).Attributes())
+}
+
+func Test_transformNode_Tagged_NilRelationship(t *testing.T) {
+	transformed, included := transformNode(taggedPerson{PersonID: "1234"}, baseURL)
+
+	assert.Equal(t, 1, len(transformed.Relationships))
+	assert.Nil(t, transformed.Relationships["company"].Data)
+	assert.Nil(t, transformed.Meta)
+	assert.Equal(t, 0, len(transformed.Links))
+	assert.Equal(t, 0, len(included))
+}
+
+func Test_transformNodes_Tagged(t *testing.T) {
+	nodes, _ := transformNodes([]*taggedCompany{{CompanyID: 1}, {CompanyID: 2}}, baseURL)
+
+	assert.Equal(t, 2, len(nodes))
+	assert.Equal(t, "1", nodes[0].ID)
+	assert.Equal(t, "2", nodes[1].ID)
+	assert.Equal(t, "companies", nodes[1].Type)
+}
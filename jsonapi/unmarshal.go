@@ -0,0 +1,437 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// IDSettable method for assigning the ID of a resource decoded from a request document
+type IDSettable interface {
+	SetID(id string) error
+}
+
+type internalPayload struct {
+	Data json.RawMessage `json:"data"`
+}
+
+type internalResource struct {
+	ID            string                     `json:"id"`
+	Type          string                     `json:"type"`
+	Attributes    json.RawMessage            `json:"attributes"`
+	Relationships map[string]json.RawMessage `json:"relationships"`
+}
+
+// Unmarshal decodes a JSON:API request document containing a single resource object into the provided Node pointer.
+// The attributes object is decoded into the struct returned by Attributes() when it is a pointer, otherwise into the node itself.
+// The resource id is assigned via SetID when the node implements IDSettable.
+// Relationship linkage is bound via SetRelationship when the node implements RelationshipSettable, reporting ErrRelationshipShape errors at the relationship data.
+// Structs annotated with jsonapi struct tags are decoded by their tags: the primary field is assigned the id, attributes are decoded by their attr names,
+// and relation fields are assigned related structs (or slices of them) created with the type and id of the resource linkage.
+func Unmarshal(body []byte, node interface{}) Errors {
+	if value := reflect.ValueOf(node); value.Kind() != reflect.Ptr || value.IsNil() {
+		return Errors{invalidUnmarshalTarget(node)}
+	}
+
+	data, errs := unmarshalPayload(body)
+	if errs.HasErrors() {
+		return errs
+	}
+
+	return unmarshalResource(data, node, "/data")
+}
+
+// UnmarshalCollection decodes a JSON:API request document containing an array of resource objects into the provided slice pointer.
+// The slice elements may either be structs or pointers to structs.
+func UnmarshalCollection(body []byte, nodes interface{}) Errors {
+	slice := reflect.ValueOf(nodes)
+	if slice.Kind() != reflect.Ptr || slice.IsNil() || slice.Elem().Kind() != reflect.Slice {
+		return Errors{invalidUnmarshalTarget(nodes)}
+	}
+
+	data, errs := unmarshalPayload(body)
+	if errs.HasErrors() {
+		return errs
+	}
+
+	var resources []json.RawMessage
+	if err := json.Unmarshal(data, &resources); err != nil {
+		return Errors{invalidDocumentError("/data", "data must be an array of resource objects")}
+	}
+
+	sliceType := slice.Elem().Type()
+	elemType := sliceType.Elem()
+	result := reflect.MakeSlice(sliceType, 0, len(resources))
+
+	for index, resource := range resources {
+		elem := reflect.New(elemType)
+		target := elem
+		if elemType.Kind() == reflect.Ptr {
+			elem.Elem().Set(reflect.New(elemType.Elem()))
+			target = elem.Elem()
+		}
+
+		errs = append(errs, unmarshalResource(resource, target.Interface(), fmt.Sprintf("/data/%d", index))...)
+		result = reflect.Append(result, elem.Elem())
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+
+	slice.Elem().Set(result)
+
+	return nil
+}
+
+func unmarshalPayload(body []byte) (json.RawMessage, Errors) {
+	var payload internalPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, Errors{{
+			Status: Status(http.StatusBadRequest),
+			Title:  "Invalid Request Document.",
+			Detail: err.Error(),
+		}}
+	}
+
+	if len(payload.Data) == 0 || string(payload.Data) == "null" {
+		return nil, Errors{invalidDocumentError("/data", "data is a required member of the request document")}
+	}
+
+	return payload.Data, nil
+}
+
+func unmarshalResource(data json.RawMessage, node interface{}, pointer string) (errs Errors) {
+	var resource internalResource
+	if err := json.Unmarshal(data, &resource); err != nil {
+		return Errors{invalidDocumentError(pointer, "data must be a resource object")}
+	}
+
+	if len(resource.Type) == 0 {
+		return Errors{invalidDocumentError(pointer+"/type", "type is a required member of a resource object")}
+	}
+
+	metadata := unmarshalTagMetadata(node)
+
+	if err := checkResourceType(node, metadata, resource.Type, pointer+"/type"); err != nil {
+		return Errors{*err}
+	}
+
+	if len(resource.ID) > 0 {
+		if err := setResourceID(node, metadata, resource.ID); err != nil {
+			errs = append(errs, invalidDocumentError(pointer+"/id", err.Error()))
+		}
+	}
+
+	if len(resource.Attributes) > 0 {
+		if metadata != nil {
+			errs = append(errs, unmarshalTaggedAttributes(resource.Attributes, reflect.ValueOf(node).Elem(), metadata, pointer+"/attributes")...)
+		} else {
+			errs = append(errs, unmarshalAttributes(resource.Attributes, node, pointer+"/attributes")...)
+		}
+	}
+
+	if len(resource.Relationships) > 0 {
+		if _, isSettable := node.(RelationshipSettable); !isSettable && metadata != nil {
+			errs = append(errs, unmarshalTaggedRelationships(resource.Relationships, reflect.ValueOf(node).Elem(), metadata, pointer+"/relationships")...)
+		} else {
+			errs = append(errs, unmarshalRelationships(resource.Relationships, node, pointer+"/relationships")...)
+		}
+	}
+
+	return
+}
+
+// unmarshalTagMetadata returns the struct tags of the node pointer, nil when the node implements Node or has no primary tag
+func unmarshalTagMetadata(node interface{}) *tagMetadata {
+	if _, isNode := node.(Node); isNode {
+		return nil
+	}
+
+	value := reflect.ValueOf(node)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	return getTagMetadata(value.Elem().Type())
+}
+
+// checkResourceType returns a 409 Conflict Error when the type differs from the type of the Node or its primary tag
+func checkResourceType(node interface{}, metadata *tagMetadata, resourceType string, pointer string) *Error {
+	expected := ""
+	if typedNode, isNode := node.(Node); isNode {
+		expected = typedNode.Type()
+	} else if metadata != nil {
+		expected = metadata.resourceType
+	}
+
+	if len(expected) == 0 || expected == resourceType {
+		return nil
+	}
+
+	err := Conflict(pointer, fmt.Sprintf("%s is not a supported resource type, expected %s", resourceType, expected))
+	return &err
+}
+
+// setResourceID assigns the id via SetID, or to the primary field of a tagged struct
+func setResourceID(node interface{}, metadata *tagMetadata, id string) error {
+	if settableNode, isSettable := node.(IDSettable); isSettable {
+		return settableNode.SetID(id)
+	}
+
+	if metadata == nil {
+		return nil
+	}
+
+	field := reflect.ValueOf(node).Elem().FieldByIndex(metadata.primary)
+	if field.Kind() == reflect.String {
+		field.SetString(id)
+		return nil
+	}
+
+	if err := json.Unmarshal([]byte(id), field.Addr().Interface()); err != nil {
+		return fmt.Errorf("%s is not a valid id of %s", id, metadata.resourceType)
+	}
+
+	return nil
+}
+
+func unmarshalAttributes(attributes json.RawMessage, node interface{}, pointer string) Errors {
+	var target interface{} = node
+	if attributeNode, isAttributeable := node.(Attributeable); isAttributeable {
+		if value := reflect.ValueOf(attributeNode.Attributes()); value.Kind() == reflect.Ptr && !value.IsNil() {
+			target = value.Interface()
+		}
+	}
+
+	if err := json.Unmarshal(attributes, target); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && len(typeErr.Field) > 0 {
+			pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+		return Errors{InvalidAttribute(pointer, err.Error())}
+	}
+
+	return nil
+}
+
+// unmarshalTaggedAttributes decodes the attributes into the fields of the attr tags by their tag names
+func unmarshalTaggedAttributes(attributes json.RawMessage, value reflect.Value, metadata *tagMetadata, pointer string) (errs Errors) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(attributes, &members); err != nil {
+		return Errors{InvalidAttribute(pointer, "attributes must be an object")}
+	}
+
+	for _, attribute := range metadata.attributes {
+		member, exists := members[attribute.name]
+		if !exists {
+			continue
+		}
+
+		attributePointer := pointer + "/" + escapePointer(attribute.name)
+		if err := json.Unmarshal(member, value.FieldByIndex(attribute.index).Addr().Interface()); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &typeErr) && len(typeErr.Field) > 0 {
+				attributePointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+			}
+			errs = append(errs, InvalidAttribute(attributePointer, err.Error()))
+		}
+	}
+
+	return
+}
+
+// unmarshalTaggedRelationships binds the linkage of the relation tags, creating the related structs with their type and id
+func unmarshalTaggedRelationships(relationships map[string]json.RawMessage, value reflect.Value, metadata *tagMetadata, pointer string) (errs Errors) {
+	for _, field := range metadata.relationships {
+		relationship, exists := relationships[field.name]
+		if !exists {
+			continue
+		}
+
+		relationshipPointer := pointer + "/" + escapePointer(field.name)
+
+		linkage, relationshipErrs := unmarshalRelationship(relationship, relationshipPointer)
+		if relationshipErrs.HasErrors() {
+			errs = append(errs, relationshipErrs...)
+			continue
+		}
+
+		errs = append(errs, bindTaggedRelationship(value.FieldByIndex(field.index), linkage, relationshipPointer)...)
+	}
+
+	return
+}
+
+func bindTaggedRelationship(field reflect.Value, linkage RelationshipLinkage, pointer string) Errors {
+	if field.Kind() == reflect.Slice {
+		ids, err := linkage.Many()
+		if err != nil {
+			return Errors{InvalidRelationship(pointer+"/data", err.Error())}
+		}
+
+		related := reflect.MakeSlice(field.Type(), 0, len(ids))
+		var errs Errors
+		for index, id := range ids {
+			value, relatedErrs := relatedValue(field.Type().Elem(), id, fmt.Sprintf("%s/data/%d", pointer, index))
+			if !value.IsValid() {
+				return nil
+			}
+			errs = append(errs, relatedErrs...)
+			related = reflect.Append(related, value)
+		}
+
+		if !errs.HasErrors() {
+			field.Set(related)
+		}
+		return errs
+	}
+
+	id, err := linkage.One()
+	if err != nil {
+		return Errors{InvalidRelationship(pointer+"/data", err.Error())}
+	}
+
+	if id == nil {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+
+	value, errs := relatedValue(field.Type(), *id, pointer+"/data")
+	if value.IsValid() && !errs.HasErrors() {
+		field.Set(value)
+	}
+	return errs
+}
+
+// relatedValue creates the related struct (or pointer) of the resource identifier, invalid when its id cannot be assigned
+func relatedValue(relatedType reflect.Type, identifier ResourceIdentifier, pointer string) (reflect.Value, Errors) {
+	structType := indirectType(relatedType)
+	if structType.Kind() != reflect.Struct {
+		return reflect.Value{}, nil
+	}
+
+	related := reflect.New(structType)
+	metadata := unmarshalTagMetadata(related.Interface())
+	if _, isSettable := related.Interface().(IDSettable); !isSettable && metadata == nil {
+		return reflect.Value{}, nil
+	}
+
+	value := related
+	if relatedType.Kind() != reflect.Ptr {
+		value = related.Elem()
+	}
+
+	if err := checkResourceType(related.Interface(), metadata, identifier.Type, pointer+"/type"); err != nil {
+		return value, Errors{*err}
+	}
+
+	if err := setResourceID(related.Interface(), metadata, identifier.ID); err != nil {
+		return value, Errors{InvalidRelationship(pointer+"/id", err.Error())}
+	}
+
+	return value, nil
+}
+
+func unmarshalRelationships(relationships map[string]json.RawMessage, node interface{}, pointer string) (errs Errors) {
+	settableNode, isSettable := node.(RelationshipSettable)
+	if !isSettable {
+		return nil
+	}
+
+	names := make([]string, 0, len(relationships))
+	for name := range relationships {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		relationshipPointer := pointer + "/" + escapePointer(name)
+
+		linkage, relationshipErrs := unmarshalRelationship(relationships[name], relationshipPointer)
+		if relationshipErrs.HasErrors() {
+			errs = append(errs, relationshipErrs...)
+			continue
+		}
+
+		if err := settableNode.SetRelationship(name, linkage); err != nil {
+			if errors.Is(err, ErrRelationshipShape) {
+				errs = append(errs, InvalidRelationship(relationshipPointer+"/data", err.Error()))
+			} else {
+				errs = append(errs, InvalidRelationship(relationshipPointer, err.Error()))
+			}
+		}
+	}
+
+	return
+}
+
+func unmarshalRelationship(relationship json.RawMessage, pointer string) (RelationshipLinkage, Errors) {
+	var payload internalPayload
+	if err := json.Unmarshal(relationship, &payload); err != nil {
+		return RelationshipLinkage{}, Errors{InvalidRelationship(pointer, "relationship must be an object")}
+	}
+
+	data := strings.TrimSpace(string(payload.Data))
+	switch {
+	case len(data) == 0:
+		return RelationshipLinkage{}, Errors{InvalidRelationship(pointer, "data is a required member of a relationship object")}
+
+	case data == "null":
+		return RelationshipLinkage{Data: []ResourceIdentifier{}}, nil
+
+	case strings.HasPrefix(data, "["):
+		var ids []ResourceIdentifier
+		if err := json.Unmarshal(payload.Data, &ids); err != nil {
+			return RelationshipLinkage{}, Errors{InvalidRelationship(pointer+"/data", "data must be an array of resource identifier objects")}
+		}
+
+		var errs Errors
+		for index, id := range ids {
+			if len(id.ID) == 0 || len(id.Type) == 0 {
+				errs = append(errs, InvalidRelationship(fmt.Sprintf("%s/data/%d", pointer, index), "resource identifier must contain type and id"))
+			}
+		}
+
+		return RelationshipLinkage{ToMany: true, Data: ids}, errs
+
+	default:
+		var id ResourceIdentifier
+		if err := json.Unmarshal(payload.Data, &id); err != nil {
+			return RelationshipLinkage{}, Errors{InvalidRelationship(pointer+"/data", "data must be a resource identifier object")}
+		}
+
+		if len(id.ID) == 0 || len(id.Type) == 0 {
+			return RelationshipLinkage{}, Errors{InvalidRelationship(pointer+"/data", "resource identifier must contain type and id")}
+		}
+
+		return RelationshipLinkage{Data: []ResourceIdentifier{id}}, nil
+	}
+}
+
+func invalidDocumentError(pointer string, detail string) Error {
+	return Error{
+		Status: Status(http.StatusBadRequest),
+		Title:  "Invalid Request Document.",
+		Detail: detail,
+		Source: ErrorSource{
+			Pointer: pointer,
+		},
+	}
+}
+
+// invalidUnmarshalTarget logs the type of the target for the server, without exposing it in the error sent to the client
+func invalidUnmarshalTarget(target interface{}) Error {
+	log.Printf("jsonapi: cannot unmarshal into %T, a non-nil pointer is required", target)
+
+	return Error{
+		Status: Status(http.StatusInternalServerError),
+		Title:  "Invalid Unmarshal Target.",
+		Detail: "the request document could not be decoded",
+	}
+}
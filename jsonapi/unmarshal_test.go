@@ -0,0 +1,430 @@
+package jsonapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+type Person struct {
+	PersonID  string `json:"-"`
+	FirstName string `json:"firstName"`
+	Age       int    `json:"age"`
+}
+
+func (person Person) ID() string {
+	return person.PersonID
+}
+
+func (person Person) Type() string {
+	return "people"
+}
+
+func (person *Person) SetID(id string) error {
+	if id == "invalid" {
+		return errors.New("id is invalid")
+	}
+	person.PersonID = id
+	return nil
+}
+
+type PersonAttributes struct {
+	FirstName string `json:"firstName"`
+}
+
+type AttributedPerson struct {
+	PersonID   string
+	Attributed PersonAttributes
+}
+
+func (person AttributedPerson) ID() string {
+	return person.PersonID
+}
+
+func (person AttributedPerson) Type() string {
+	return "people"
+}
+
+func (person *AttributedPerson) Attributes() interface{} {
+	return &person.Attributed
+}
+
+func Test_Unmarshal(t *testing.T) {
+	body := []byte(`{"data":{"id":"1234","type":"people","attributes":{"firstName":"John","age":30}}}`)
+
+	var person Person
+	errs := jsonapi.Unmarshal(body, &person)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, "1234", person.PersonID)
+	assert.Equal(t, "John", person.FirstName)
+	assert.Equal(t, 30, person.Age)
+}
+
+func Test_Unmarshal_NoID(t *testing.T) {
+	body := []byte(`{"data":{"type":"people","attributes":{"firstName":"John"}}}`)
+
+	var person Person
+	errs := jsonapi.Unmarshal(body, &person)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, "", person.PersonID)
+	assert.Equal(t, "John", person.FirstName)
+}
+
+func Test_Unmarshal_Attributeable(t *testing.T) {
+	body := []byte(`{"data":{"id":"1234","type":"people","attributes":{"firstName":"John"}}}`)
+
+	var person AttributedPerson
+	errs := jsonapi.Unmarshal(body, &person)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, "", person.PersonID)
+	assert.Equal(t, "John", person.Attributed.FirstName)
+}
+
+func Test_Unmarshal_NonPointer(t *testing.T) {
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	errs := jsonapi.Unmarshal([]byte(`{}`), Person{})
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusInternalServerError, errs[0].StatusCode())
+	assert.Equal(t, "the request document could not be decoded", errs[0].Detail)
+	assert.NotContains(t, errs[0].Detail, "Person")
+	assert.Contains(t, logged.String(), "cannot unmarshal into jsonapi_test.Person")
+}
+
+func Test_Unmarshal_InvalidJSON(t *testing.T) {
+	var person Person
+	errs := jsonapi.Unmarshal([]byte(`{"data":`), &person)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Nil(t, errs[0].Source)
+}
+
+func Test_Unmarshal_MissingData(t *testing.T) {
+	var person Person
+	errs := jsonapi.Unmarshal([]byte(`{"meta":{}}`), &person)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data"}, errs[0].Source)
+}
+
+func Test_Unmarshal_MissingType(t *testing.T) {
+	var person Person
+	errs := jsonapi.Unmarshal([]byte(`{"data":{"id":"1234"}}`), &person)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/type"}, errs[0].Source)
+}
+
+func Test_Unmarshal_TypeMismatch(t *testing.T) {
+	var person Person
+	errs := jsonapi.Unmarshal([]byte(`{"data":{"id":"1234","type":"companies"}}`), &person)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusConflict, errs[0].StatusCode())
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/type"}, errs[0].Source)
+}
+
+func Test_Unmarshal_InvalidID(t *testing.T) {
+	var person Person
+	errs := jsonapi.Unmarshal([]byte(`{"data":{"id":"invalid","type":"people"}}`), &person)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, "id is invalid", errs[0].Detail)
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/id"}, errs[0].Source)
+}
+
+func Test_Unmarshal_InvalidAttribute(t *testing.T) {
+	var person Person
+	errs := jsonapi.Unmarshal([]byte(`{"data":{"type":"people","attributes":{"age":"thirty"}}}`), &person)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/attributes/age"}, errs[0].Source)
+}
+
+func Test_UnmarshalCollection(t *testing.T) {
+	body := []byte(`{"data":[{"id":"1","type":"people","attributes":{"firstName":"John"}},{"id":"2","type":"people","attributes":{"firstName":"Sally"}}]}`)
+
+	var people []Person
+	errs := jsonapi.UnmarshalCollection(body, &people)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, 2, len(people))
+	assert.Equal(t, "1", people[0].PersonID)
+	assert.Equal(t, "John", people[0].FirstName)
+	assert.Equal(t, "2", people[1].PersonID)
+	assert.Equal(t, "Sally", people[1].FirstName)
+}
+
+func Test_UnmarshalCollection_PtrSlice(t *testing.T) {
+	body := []byte(`{"data":[{"id":"1","type":"people","attributes":{"firstName":"John"}}]}`)
+
+	var people []*Person
+	errs := jsonapi.UnmarshalCollection(body, &people)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, 1, len(people))
+	assert.Equal(t, "1", people[0].PersonID)
+	assert.Equal(t, "John", people[0].FirstName)
+}
+
+func Test_UnmarshalCollection_NotArray(t *testing.T) {
+	body := []byte(`{"data":{"id":"1","type":"people"}}`)
+
+	var people []Person
+	errs := jsonapi.UnmarshalCollection(body, &people)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data"}, errs[0].Source)
+}
+
+func Test_UnmarshalCollection_InvalidResources(t *testing.T) {
+	body := []byte(`{"data":[{"id":"1","type":"people"},{"id":"2","type":"companies"},{"id":"3","type":"people","attributes":{"age":"three"}}]}`)
+
+	var people []Person
+	errs := jsonapi.UnmarshalCollection(body, &people)
+
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/1/type"}, errs[0].Source)
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/2/attributes/age"}, errs[1].Source)
+	assert.Nil(t, people)
+}
+
+func Test_UnmarshalCollection_NonSlice(t *testing.T) {
+	var person Person
+	errs := jsonapi.UnmarshalCollection([]byte(`{"data":[]}`), &person)
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusInternalServerError, errs[0].StatusCode())
+}
+
+type Article struct {
+	ArticleID  string   `json:"-"`
+	Title      string   `json:"title"`
+	AuthorID   string   `json:"-"`
+	CommentIDs []string `json:"-"`
+}
+
+func (article Article) ID() string {
+	return article.ArticleID
+}
+
+func (article Article) Type() string {
+	return "articles"
+}
+
+func (article *Article) SetRelationship(name string, linkage jsonapi.RelationshipLinkage) error {
+	switch name {
+	case "author":
+		id, err := linkage.One()
+		if err != nil {
+			return err
+		}
+		article.AuthorID = ""
+		if id != nil {
+			article.AuthorID = id.ID
+		}
+	case "comments":
+		ids, err := linkage.Many()
+		if err != nil {
+			return err
+		}
+		article.CommentIDs = make([]string, 0, len(ids))
+		for _, id := range ids {
+			article.CommentIDs = append(article.CommentIDs, id.ID)
+		}
+	default:
+		return errors.New("unknown relationship")
+	}
+	return nil
+}
+
+func Test_Unmarshal_Relationships(t *testing.T) {
+	body := []byte(`{"data":{"type":"articles","attributes":{"title":"Hello"},"relationships":{
+		"author":{"data":{"type":"people","id":"9"}},
+		"comments":{"data":[{"type":"comments","id":"5"},{"type":"comments","id":"12"}]}
+	}}}`)
+
+	var article Article
+	errs := jsonapi.Unmarshal(body, &article)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, "Hello", article.Title)
+	assert.Equal(t, "9", article.AuthorID)
+	assert.Equal(t, []string{"5", "12"}, article.CommentIDs)
+}
+
+func Test_Unmarshal_Relationships_Empty(t *testing.T) {
+	body := []byte(`{"data":{"type":"articles","relationships":{"author":{"data":null},"comments":{"data":[]}}}}`)
+
+	article := Article{AuthorID: "9", CommentIDs: []string{"5"}}
+	errs := jsonapi.Unmarshal(body, &article)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, "", article.AuthorID)
+	assert.Equal(t, []string{}, article.CommentIDs)
+}
+
+func Test_Unmarshal_Relationships_Errors(t *testing.T) {
+	body := []byte(`{"data":{"type":"articles","relationships":{
+		"author":{"data":[{"type":"people","id":"9"},{"type":"people","id":"10"}]},
+		"comments":{"data":[{"type":"comments"}]},
+		"editor":{"links":{}},
+		"tags":{"data":"tag"}
+	}}}`)
+
+	var article Article
+	errs := jsonapi.Unmarshal(body, &article)
+
+	assert.Equal(t, 4, len(errs))
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/relationships/author/data"}, errs[0].Source)
+	assert.Equal(t, http.StatusBadRequest, errs[0].StatusCode())
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/relationships/comments/data/0"}, errs[1].Source)
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/relationships/editor"}, errs[2].Source)
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/relationships/tags/data"}, errs[3].Source)
+}
+
+func Test_Unmarshal_Relationships_EscapedPointer(t *testing.T) {
+	body := []byte(`{"data":{"type":"articles","relationships":{"co/authors":{"data":"x"},"tags~v2":{"links":{}}}}}`)
+
+	var article Article
+	errs := jsonapi.Unmarshal(body, &article)
+
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/relationships/co~1authors/data"}, errs[0].Source)
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/relationships/tags~0v2"}, errs[1].Source)
+}
+
+func Test_Unmarshal_Relationships_ShapeMismatch(t *testing.T) {
+	body := []byte(`{"data":{"type":"articles","relationships":{
+		"author":{"data":[{"type":"people","id":"9"}]},
+		"comments":{"data":{"type":"comments","id":"5"}},
+		"tags":{"data":[]}
+	}}}`)
+
+	var article Article
+	errs := jsonapi.Unmarshal(body, &article)
+
+	assert.Equal(t, 3, len(errs))
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/relationships/author/data"}, errs[0].Source)
+	assert.Equal(t, "relationship linkage has the wrong shape, data must be a resource identifier object or null", errs[0].Detail)
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/relationships/comments/data"}, errs[1].Source)
+	assert.Equal(t, http.StatusBadRequest, errs[1].StatusCode())
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/relationships/tags"}, errs[2].Source)
+	assert.Equal(t, "unknown relationship", errs[2].Detail)
+}
+
+func Test_Unmarshal_Relationships_NotSettable(t *testing.T) {
+	body := []byte(`{"data":{"type":"people","relationships":{"company":{"data":{"type":"companies","id":"1"}}}}}`)
+
+	var person Person
+	errs := jsonapi.Unmarshal(body, &person)
+
+	assert.False(t, errs.HasErrors())
+}
+
+type TaggedCompany struct {
+	CompanyID int    `jsonapi:"primary,companies"`
+	Name      string `jsonapi:"attr,name"`
+}
+
+type TaggedPerson struct {
+	PersonID  string          `jsonapi:"primary,people"`
+	FirstName string          `jsonapi:"attr,first-name"`
+	Age       int             `jsonapi:"attr,age"`
+	Company   *TaggedCompany  `jsonapi:"relation,company"`
+	Previous  []TaggedCompany `jsonapi:"relation,previous"`
+}
+
+func Test_Unmarshal_Tagged_RoundTrip(t *testing.T) {
+	original := TaggedPerson{
+		PersonID:  "1234",
+		FirstName: "John",
+		Age:       30,
+		Company:   &TaggedCompany{CompanyID: 9876},
+		Previous:  []TaggedCompany{{CompanyID: 1}, {CompanyID: 2}},
+	}
+
+	body, err := json.Marshal(jsonapi.TransformResponse(jsonapi.Response{Node: original}, "http://example.com"))
+	assert.Nil(t, err)
+
+	var person TaggedPerson
+	errs := jsonapi.Unmarshal(body, &person)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, original, person)
+}
+
+func Test_UnmarshalCollection_Tagged(t *testing.T) {
+	body := []byte(`{"data":[{"id":"1","type":"companies","attributes":{"name":"First"}},{"id":"2","type":"companies","attributes":{"name":"Second"}}]}`)
+
+	var companies []*TaggedCompany
+	errs := jsonapi.UnmarshalCollection(body, &companies)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, []*TaggedCompany{{CompanyID: 1, Name: "First"}, {CompanyID: 2, Name: "Second"}}, companies)
+}
+
+func Test_Unmarshal_Tagged_Errors(t *testing.T) {
+	tests := []struct {
+		body    string
+		pointer string
+		status  int
+	}{
+		{body: `{"data":{"type":"people"}}`, pointer: "/data/type", status: http.StatusConflict},
+		{body: `{"data":{"id":"abc","type":"companies"}}`, pointer: "/data/id", status: http.StatusBadRequest},
+		{body: `{"data":{"type":"companies","attributes":{"name":1}}}`, pointer: "/data/attributes/name", status: http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		var company TaggedCompany
+		errs := jsonapi.Unmarshal([]byte(test.body), &company)
+
+		assert.Equal(t, 1, len(errs), test.body)
+		assert.Equal(t, jsonapi.ErrorSource{Pointer: test.pointer}, errs[0].Source, test.body)
+		assert.Equal(t, test.status, errs[0].StatusCode(), test.body)
+	}
+}
+
+func Test_Unmarshal_Tagged_Relationships_Errors(t *testing.T) {
+	body := []byte(`{"data":{"type":"people","relationships":{
+		"company":{"data":[{"type":"companies","id":"1"}]},
+		"previous":{"data":[{"type":"people","id":"1"},{"type":"companies","id":"abc"}]}
+	}}}`)
+
+	var person TaggedPerson
+	errs := jsonapi.Unmarshal(body, &person)
+
+	assert.Equal(t, 3, len(errs))
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/relationships/company/data"}, errs[0].Source)
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/relationships/previous/data/0/type"}, errs[1].Source)
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/relationships/previous/data/1/id"}, errs[2].Source)
+	assert.Nil(t, person.Company)
+	assert.Nil(t, person.Previous)
+}
+
+func Test_Unmarshal_Tagged_NullRelationship(t *testing.T) {
+	body := []byte(`{"data":{"id":"1234","type":"people","relationships":{"company":{"data":null}}}}`)
+
+	person := TaggedPerson{Company: &TaggedCompany{CompanyID: 1}}
+	errs := jsonapi.Unmarshal(body, &person)
+
+	assert.False(t, errs.HasErrors())
+	assert.Equal(t, "1234", person.PersonID)
+	assert.Nil(t, person.Company)
+}
@@ -0,0 +1,67 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Write is a wrapper to CreateResponse that will serialize the response with the JSON:API media type.
+// The HTTP status is derived from the response errors, or 200 OK without errors.
+func Write(w http.ResponseWriter, r *http.Request, resp Response) error {
+	return WriteTransformed(w, CreateResponse(r)(resp))
+}
+
+// WriteCollection is a wrapper to CreateCollectionResponse that will serialize the response with the JSON:API media type.
+// The HTTP status is derived from the response errors, or 200 OK without errors.
+func WriteCollection(w http.ResponseWriter, r *http.Request, resp CollectionResponse) error {
+	return WriteTransformed(w, CreateCollectionResponse(r)(resp))
+}
+
+// WriteTransformed will serialize the TransformedResponse with the JSON:API media type and the status of StatusCode.
+// A JSON:API Content-Type already set by negotiation middleware is kept.
+func WriteTransformed(w http.ResponseWriter, response TransformedResponse) error {
+	header := w.Header()
+	if !strings.HasPrefix(header.Get(ContentType), MediaType) {
+		header.Set(ContentType, MediaType)
+	}
+
+	w.WriteHeader(response.StatusCode())
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StatusCode derives the HTTP status of the response from its errors: https://jsonapi.org/format/#errors
+// A single status is used as is. When the errors disagree, the most generally applicable status is used:
+// 400 Bad Request if every error is a 4xx client error, otherwise 500 Internal Server Error.
+// Errors without a status are ignored, and 500 Internal Server Error is used if none have a status.
+func (response TransformedResponse) StatusCode() int {
+	if len(response.Errors) == 0 {
+		return http.StatusOK
+	}
+
+	status := 0
+	for _, err := range response.Errors {
+		errStatus := int(err.Status)
+
+		switch {
+		case errStatus == 0 || errStatus == status:
+			continue
+		case status == 0:
+			status = errStatus
+		case isClientError(status) && isClientError(errStatus):
+			status = http.StatusBadRequest
+		default:
+			return http.StatusInternalServerError
+		}
+	}
+
+	if status == 0 {
+		return http.StatusInternalServerError
+	}
+
+	return status
+}
+
+func isClientError(status int) bool {
+	return status >= 400 && status < 500
+}
@@ -0,0 +1,98 @@
+package jsonapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Write(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://example.com/people/1", nil)
+
+	err := jsonapi.Write(w, r, jsonapi.Response{Node: Person{PersonID: "1", FirstName: "John"}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, jsonapi.MediaType, w.Header().Get(jsonapi.ContentType))
+	assert.JSONEq(t, `{
+		"data": {
+			"id": "1",
+			"type": "people",
+			"attributes": {"firstName": "John", "age": 0}
+		},
+		"links": {"self": "http://example.com/people/1"}
+	}`, w.Body.String())
+}
+
+func Test_Write_Errors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://example.com/people/1", nil)
+
+	err := jsonapi.Write(w, r, jsonapi.Response{Errors: jsonapi.Errors{{Title: "Not Found.", Status: jsonapi.Status(http.StatusNotFound)}}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func Test_Write_InvalidNode(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://example.com/people/1", nil)
+
+	err := jsonapi.Write(w, r, jsonapi.Response{Node: struct{ Name string }{Name: "John"}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.NotContains(t, w.Body.String(), `"data"`)
+}
+
+func Test_WriteCollection_InvalidInclude(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://example.com/articles?include=unknown", nil)
+
+	err := jsonapi.WriteCollection(w, r, jsonapi.CollectionResponse{Nodes: []IncludeArticle{includeArticle}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_WriteTransformed_NegotiatedContentType(t *testing.T) {
+	w := httptest.NewRecorder()
+	negotiated := jsonapi.MediaTypeParams{Profile: []string{jsonapi.CursorPaginationProfile}}.String()
+	w.Header().Set(jsonapi.ContentType, negotiated)
+
+	err := jsonapi.WriteTransformed(w, jsonapi.TransformedResponse{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, negotiated, w.Header().Get(jsonapi.ContentType))
+}
+
+func Test_TransformedResponse_StatusCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		expected int
+	}{
+		{"NoErrors", nil, http.StatusOK},
+		{"Single", []int{http.StatusNotFound}, http.StatusNotFound},
+		{"Same", []int{http.StatusConflict, http.StatusConflict}, http.StatusConflict},
+		{"ClientErrors", []int{http.StatusNotFound, http.StatusConflict}, http.StatusBadRequest},
+		{"MixedErrors", []int{http.StatusNotFound, http.StatusServiceUnavailable}, http.StatusInternalServerError},
+		{"MissingStatus", []int{0, http.StatusForbidden}, http.StatusForbidden},
+		{"NoStatus", []int{0}, http.StatusInternalServerError},
+	}
+
+	for _, test := range tests {
+		var errs jsonapi.Errors
+		for _, status := range test.statuses {
+			errs = append(errs, jsonapi.Error{Title: "Error.", Status: jsonapi.Status(status)})
+		}
+
+		response := jsonapi.TransformResponse(jsonapi.Response{Errors: errs}, "http://example.com")
+
+		assert.Equal(t, test.expected, response.StatusCode(), test.name)
+	}
+}
@@ -0,0 +1,28 @@
+// Package jsonapiecho is a collection of JSON:API middleware and helpers for the Echo framework.
+package jsonapiecho
+
+import (
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/alehechka/go-jsonapi/middleware/nethttp"
+	"github.com/labstack/echo/v4"
+)
+
+// ContentType is a middleware function to set the Content-Type header to the official JSON:API header.
+func ContentType(next echo.HandlerFunc) echo.HandlerFunc {
+	return echo.WrapMiddleware(nethttp.ContentType)(next)
+}
+
+// SupportedPagination will short-circuit if a pagination query that is not in the provided, supported options.
+func SupportedPagination(supportedOptions ...jsonapi.PaginationOption) echo.MiddlewareFunc {
+	return echo.WrapMiddleware(nethttp.SupportedPagination(supportedOptions...))
+}
+
+// UnsupportedPagination will short-circuit if one of the provided, unsupported query options is provided in the request.
+func UnsupportedPagination(unsupportedOptions ...jsonapi.PaginationOption) echo.MiddlewareFunc {
+	return echo.WrapMiddleware(nethttp.UnsupportedPagination(unsupportedOptions...))
+}
+
+// MaximumPaginationSize will short-circuit if one of the provided pagination query options exceeds the provided maximum.
+func MaximumPaginationSize(maxSize int) echo.MiddlewareFunc {
+	return echo.WrapMiddleware(nethttp.MaximumPaginationSize(maxSize))
+}
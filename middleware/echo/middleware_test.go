@@ -0,0 +1,74 @@
+package jsonapiecho_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	jsonapiecho "github.com/alehechka/go-jsonapi/middleware/echo"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func serve(middleware echo.MiddlewareFunc, target string) (w *httptest.ResponseRecorder, isCalled bool) {
+	e := echo.New()
+	e.Use(middleware)
+	e.GET("/", func(c echo.Context) error {
+		isCalled = true
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	w = httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest("GET", target, nil))
+
+	return
+}
+
+func Test_ContentType(t *testing.T) {
+	w, isCalled := serve(jsonapiecho.ContentType, "/")
+
+	assert.Equal(t, true, isCalled)
+	assert.Equal(t, jsonapi.MediaType, w.Header().Get(jsonapi.ContentType))
+}
+
+func Test_SupportedPagination_Abort(t *testing.T) {
+	w, isCalled := serve(jsonapiecho.SupportedPagination(jsonapi.PageOffset), "/?page[offset]=10&page[size]=10")
+
+	assert.Equal(t, false, isCalled)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, jsonapi.MediaType, w.Header().Get(jsonapi.ContentType))
+}
+
+func Test_SupportedPagination_Next(t *testing.T) {
+	w, isCalled := serve(jsonapiecho.SupportedPagination(jsonapi.PageOffset, jsonapi.PageLimit), "/?page[offset]=10&page[limit]=10")
+
+	assert.Equal(t, true, isCalled)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func Test_UnsupportedPagination_Abort(t *testing.T) {
+	w, isCalled := serve(jsonapiecho.UnsupportedPagination(jsonapi.PageOffset), "/?page[offset]=10&page[size]=10")
+
+	assert.Equal(t, false, isCalled)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_UnsupportedPagination_Next(t *testing.T) {
+	_, isCalled := serve(jsonapiecho.UnsupportedPagination(jsonapi.PageAfter), "/?page[offset]=10&page[size]=10")
+
+	assert.Equal(t, true, isCalled)
+}
+
+func Test_MaximumPaginationSize_Abort(t *testing.T) {
+	w, isCalled := serve(jsonapiecho.MaximumPaginationSize(100), "/?page[limit]=1000&page[size]=10")
+
+	assert.Equal(t, false, isCalled)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_MaximumPaginationSize_Next(t *testing.T) {
+	_, isCalled := serve(jsonapiecho.MaximumPaginationSize(100), "/?page[limit]=100&page[size]=10")
+
+	assert.Equal(t, true, isCalled)
+}
@@ -0,0 +1,19 @@
+package jsonapiecho
+
+import (
+	"strings"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/labstack/echo/v4"
+)
+
+// Write sends the TransformedResponse with the status of its errors (see TransformedResponse.StatusCode) and the JSON:API media type.
+// A JSON:API Content-Type already set by ContentType or negotiation middleware is kept.
+func Write(c echo.Context, response jsonapi.TransformedResponse) error {
+	header := c.Response().Header()
+	if !strings.HasPrefix(header.Get(jsonapi.ContentType), jsonapi.MediaType) {
+		header.Set(jsonapi.ContentType, jsonapi.MediaType)
+	}
+
+	return c.JSON(response.StatusCode(), response)
+}
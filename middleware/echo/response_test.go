@@ -0,0 +1,58 @@
+package jsonapiecho_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	jsonapiecho "github.com/alehechka/go-jsonapi/middleware/echo"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Write(t *testing.T) {
+	e := echo.New()
+	w := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest("GET", "/", nil), w)
+
+	err := jsonapiecho.Write(c, jsonapi.TransformResponse(jsonapi.Response{
+		Errors: jsonapi.Errors{{Title: "Not Found.", Status: jsonapi.Status(http.StatusNotFound)}},
+	}, "http://example.com"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, jsonapi.MediaType, w.Header().Get(jsonapi.ContentType))
+	assert.JSONEq(t, `{"errors": [{"status": "404", "title": "Not Found."}]}`, w.Body.String())
+}
+
+func Test_Write_NegotiatedContentType(t *testing.T) {
+	e := echo.New()
+	w := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest("GET", "/", nil), w)
+
+	negotiated := jsonapi.MediaTypeParams{Profile: []string{jsonapi.CursorPaginationProfile}}.String()
+	w.Header().Set(jsonapi.ContentType, negotiated)
+
+	err := jsonapiecho.Write(c, jsonapi.TransformedResponse{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, negotiated, w.Header().Get(jsonapi.ContentType))
+}
+
+func Test_Write_ClientErrors(t *testing.T) {
+	e := echo.New()
+	w := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest("GET", "/", nil), w)
+
+	err := jsonapiecho.Write(c, jsonapi.TransformResponse(jsonapi.Response{
+		Errors: jsonapi.Errors{
+			{Title: "Not Found.", Status: jsonapi.Status(http.StatusNotFound)},
+			{Title: "Conflict.", Status: jsonapi.Status(http.StatusConflict)},
+		},
+	}, "http://example.com"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
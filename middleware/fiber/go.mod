@@ -0,0 +1,29 @@
+module github.com/alehechka/go-jsonapi/middleware/fiber
+
+go 1.22
+
+require (
+	github.com/alehechka/go-jsonapi v0.0.0-00010101000000-000000000000
+	github.com/gofiber/fiber/v2 v2.52.5
+	github.com/stretchr/testify v1.7.1
+)
+
+require (
+	github.com/andybalholm/brotli v1.0.5 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/google/uuid v1.5.0 // indirect
+	github.com/klauspost/compress v1.18.0 // indirect
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.20 // indirect
+	github.com/mattn/go-runewidth v0.0.15 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/rivo/uniseg v0.2.0 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+	github.com/valyala/fasthttp v1.51.0 // indirect
+	github.com/valyala/tcplisten v1.0.0 // indirect
+	golang.org/x/sys v0.15.0 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
+
+// the adapter is not published until a release of the root module includes middleware/nethttp
+replace github.com/alehechka/go-jsonapi => ../..
@@ -0,0 +1,31 @@
+// Package jsonapifiber is a collection of JSON:API middleware and helpers for the Fiber framework.
+package jsonapifiber
+
+import (
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/alehechka/go-jsonapi/middleware/nethttp"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+)
+
+var contentType = adaptor.HTTPMiddleware(nethttp.ContentType)
+
+// ContentType is a middleware function to set the Content-Type header to the official JSON:API header.
+func ContentType(c *fiber.Ctx) error {
+	return contentType(c)
+}
+
+// SupportedPagination will short-circuit if a pagination query that is not in the provided, supported options.
+func SupportedPagination(supportedOptions ...jsonapi.PaginationOption) fiber.Handler {
+	return adaptor.HTTPMiddleware(nethttp.SupportedPagination(supportedOptions...))
+}
+
+// UnsupportedPagination will short-circuit if one of the provided, unsupported query options is provided in the request.
+func UnsupportedPagination(unsupportedOptions ...jsonapi.PaginationOption) fiber.Handler {
+	return adaptor.HTTPMiddleware(nethttp.UnsupportedPagination(unsupportedOptions...))
+}
+
+// MaximumPaginationSize will short-circuit if one of the provided pagination query options exceeds the provided maximum.
+func MaximumPaginationSize(maxSize int) fiber.Handler {
+	return adaptor.HTTPMiddleware(nethttp.MaximumPaginationSize(maxSize))
+}
@@ -0,0 +1,78 @@
+package jsonapifiber_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	jsonapifiber "github.com/alehechka/go-jsonapi/middleware/fiber"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func serve(t *testing.T, middleware fiber.Handler, target string) (res *http.Response, isCalled bool) {
+	app := fiber.New()
+	app.Use(middleware)
+	app.Get("/", func(c *fiber.Ctx) error {
+		isCalled = true
+		return c.SendStatus(http.StatusNoContent)
+	})
+
+	res, err := app.Test(httptest.NewRequest("GET", target, nil))
+	assert.Nil(t, err)
+
+	return
+}
+
+func Test_ContentType(t *testing.T) {
+	res, isCalled := serve(t, jsonapifiber.ContentType, "/")
+
+	assert.Equal(t, true, isCalled)
+	assert.Equal(t, jsonapi.MediaType, res.Header.Get(jsonapi.ContentType))
+}
+
+func Test_SupportedPagination_Abort(t *testing.T) {
+	res, isCalled := serve(t, jsonapifiber.SupportedPagination(jsonapi.PageOffset), "/?page[offset]=10&page[size]=10")
+
+	assert.Equal(t, false, isCalled)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	assert.Equal(t, jsonapi.MediaType, res.Header.Get(jsonapi.ContentType))
+
+	body, _ := io.ReadAll(res.Body)
+	assert.Contains(t, string(body), `"parameter":"page[size]"`)
+}
+
+func Test_SupportedPagination_Next(t *testing.T) {
+	res, isCalled := serve(t, jsonapifiber.SupportedPagination(jsonapi.PageOffset, jsonapi.PageLimit), "/?page[offset]=10&page[limit]=10")
+
+	assert.Equal(t, true, isCalled)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+}
+
+func Test_UnsupportedPagination_Abort(t *testing.T) {
+	res, isCalled := serve(t, jsonapifiber.UnsupportedPagination(jsonapi.PageOffset), "/?page[offset]=10&page[size]=10")
+
+	assert.Equal(t, false, isCalled)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func Test_UnsupportedPagination_Next(t *testing.T) {
+	_, isCalled := serve(t, jsonapifiber.UnsupportedPagination(jsonapi.PageAfter), "/?page[offset]=10&page[size]=10")
+
+	assert.Equal(t, true, isCalled)
+}
+
+func Test_MaximumPaginationSize_Abort(t *testing.T) {
+	res, isCalled := serve(t, jsonapifiber.MaximumPaginationSize(100), "/?page[limit]=1000&page[size]=10")
+
+	assert.Equal(t, false, isCalled)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func Test_MaximumPaginationSize_Next(t *testing.T) {
+	_, isCalled := serve(t, jsonapifiber.MaximumPaginationSize(100), "/?page[limit]=100&page[size]=10")
+
+	assert.Equal(t, true, isCalled)
+}
@@ -0,0 +1,25 @@
+package jsonapifiber
+
+import (
+	"strings"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Write sends the TransformedResponse with the status of its errors (see TransformedResponse.StatusCode) and the JSON:API media type.
+// A JSON:API Content-Type already set by ContentType or negotiation middleware is kept.
+func Write(c *fiber.Ctx, response jsonapi.TransformedResponse) error {
+	// the header value references fasthttp's buffer, which is reused when JSON sets its own Content-Type
+	contentType := strings.Clone(c.GetRespHeader(jsonapi.ContentType))
+	if !strings.HasPrefix(contentType, jsonapi.MediaType) {
+		contentType = jsonapi.MediaType
+	}
+
+	if err := c.Status(response.StatusCode()).JSON(response); err != nil {
+		return err
+	}
+
+	c.Set(jsonapi.ContentType, contentType)
+	return nil
+}
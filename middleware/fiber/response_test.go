@@ -0,0 +1,63 @@
+package jsonapifiber_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	jsonapifiber "github.com/alehechka/go-jsonapi/middleware/fiber"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Write(t *testing.T) {
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return jsonapifiber.Write(c, jsonapi.TransformResponse(jsonapi.Response{
+			Errors: jsonapi.Errors{{Title: "Not Found.", Status: jsonapi.Status(http.StatusNotFound)}},
+		}, "http://example.com"))
+	})
+
+	res, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	assert.Nil(t, err)
+
+	body, _ := io.ReadAll(res.Body)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	assert.Equal(t, jsonapi.MediaType, res.Header.Get(jsonapi.ContentType))
+	assert.JSONEq(t, `{"errors": [{"status": "404", "title": "Not Found."}]}`, string(body))
+}
+
+func Test_Write_NegotiatedContentType(t *testing.T) {
+	negotiated := jsonapi.MediaTypeParams{Profile: []string{jsonapi.CursorPaginationProfile}}.String()
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		c.Set(jsonapi.ContentType, negotiated)
+		return jsonapifiber.Write(c, jsonapi.TransformedResponse{})
+	})
+
+	res, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, negotiated, res.Header.Get(jsonapi.ContentType))
+}
+
+func Test_Write_ClientErrors(t *testing.T) {
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return jsonapifiber.Write(c, jsonapi.TransformResponse(jsonapi.Response{
+			Errors: jsonapi.Errors{
+				{Title: "Not Found.", Status: jsonapi.Status(http.StatusNotFound)},
+				{Title: "Conflict.", Status: jsonapi.Status(http.StatusConflict)},
+			},
+		}, "http://example.com"))
+	})
+
+	res, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+const noWritten = -1
+
+// Adapt runs net/http middleware as a gin.HandlerFunc.
+// The remaining gin handlers are run in place of the next http.Handler, writing to the http.ResponseWriter passed to next,
+// and are aborted if the middleware short-circuits.
+func Adapt(middleware func(http.Handler) http.Handler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		isNext := false
+		writer := c.Writer
+
+		middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			isNext = true
+			c.Request = r
+			c.Writer = adaptWriter(w, writer)
+			c.Next()
+			c.Writer = writer
+		})).ServeHTTP(writer, c.Request)
+
+		if !isNext {
+			c.Abort()
+		}
+	}
+}
+
+// adaptWriter keeps gin's own writer when the middleware did not wrap it
+func adaptWriter(w http.ResponseWriter, writer gin.ResponseWriter) gin.ResponseWriter {
+	if ginWriter, isGinWriter := w.(gin.ResponseWriter); isGinWriter {
+		return ginWriter
+	}
+
+	return &adaptedWriter{ResponseWriter: w, writer: writer, status: http.StatusOK, size: noWritten}
+}
+
+// adaptedWriter is a gin.ResponseWriter over the http.ResponseWriter that a net/http middleware passed to next.
+// Like gin's writer, the status is only written with the first write of the body or WriteHeaderNow.
+type adaptedWriter struct {
+	http.ResponseWriter
+	writer gin.ResponseWriter
+	status int
+	size   int
+}
+
+func (w *adaptedWriter) WriteHeader(code int) {
+	if code > 0 && !w.Written() {
+		w.status = code
+	}
+}
+
+func (w *adaptedWriter) WriteHeaderNow() {
+	if !w.Written() {
+		w.size = 0
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+}
+
+func (w *adaptedWriter) Write(data []byte) (n int, err error) {
+	w.WriteHeaderNow()
+	n, err = w.ResponseWriter.Write(data)
+	w.size += n
+	return
+}
+
+func (w *adaptedWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *adaptedWriter) Status() int {
+	return w.status
+}
+
+func (w *adaptedWriter) Size() int {
+	return w.size
+}
+
+func (w *adaptedWriter) Written() bool {
+	return w.size != noWritten
+}
+
+func (w *adaptedWriter) Flush() {
+	w.WriteHeaderNow()
+	if flusher, isFlusher := w.ResponseWriter.(http.Flusher); isFlusher {
+		flusher.Flush()
+	}
+}
+
+func (w *adaptedWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, isHijacker := w.ResponseWriter.(http.Hijacker)
+	if !isHijacker {
+		return nil, nil, errors.New("the wrapped http.ResponseWriter does not implement http.Hijacker")
+	}
+
+	if w.size < 0 {
+		w.size = 0
+	}
+	return hijacker.Hijack()
+}
+
+// CloseNotify reports the closing of the connection, which is shared with gin's own writer
+func (w *adaptedWriter) CloseNotify() <-chan bool {
+	return w.writer.CloseNotify()
+}
+
+func (w *adaptedWriter) Pusher() http.Pusher {
+	if pusher, isPusher := w.ResponseWriter.(http.Pusher); isPusher {
+		return pusher
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package middleware_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type contextKey string
+
+func Test_Adapt_Next(t *testing.T) {
+	engine := gin.New()
+	engine.Use(middleware.Adapt(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), contextKey("key"), "value")))
+		})
+	}))
+	engine.GET("/example", func(c *gin.Context) {
+		c.String(http.StatusOK, c.Request.Context().Value(contextKey("key")).(string))
+	})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest("GET", "/example", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "value", w.Body.String())
+}
+
+func Test_Adapt_Abort(t *testing.T) {
+	isCalled := false
+
+	engine := gin.New()
+	engine.Use(middleware.Adapt(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		})
+	}))
+	engine.GET("/example", func(c *gin.Context) {
+		isCalled = true
+	})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest("GET", "/example", nil))
+
+	assert.Equal(t, http.StatusTeapot, w.Code)
+	assert.Equal(t, false, isCalled)
+}
+
+type upperCaseWriter struct {
+	http.ResponseWriter
+}
+
+func (w upperCaseWriter) Write(data []byte) (int, error) {
+	return w.ResponseWriter.Write(bytes.ToUpper(data))
+}
+
+func Test_Adapt_WrappedWriter(t *testing.T) {
+	engine := gin.New()
+	engine.Use(middleware.Adapt(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Wrapped", "true")
+			next.ServeHTTP(upperCaseWriter{w}, r)
+		})
+	}))
+	engine.GET("/example", func(c *gin.Context) {
+		c.String(http.StatusCreated, "value")
+	})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest("GET", "/example", nil))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "true", w.Header().Get("X-Wrapped"))
+	assert.Equal(t, "VALUE", w.Body.String())
+}
+
+func Test_Adapt_WrappedWriter_Status(t *testing.T) {
+	status, size := 0, 0
+
+	engine := gin.New()
+	engine.Use(middleware.Adapt(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(upperCaseWriter{w}, r)
+		})
+	}))
+	engine.Use(func(c *gin.Context) {
+		c.Next()
+		status, size = c.Writer.Status(), c.Writer.Size()
+	})
+	engine.GET("/example", func(c *gin.Context) {
+		c.String(http.StatusAccepted, "value")
+	})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest("GET", "/example", nil))
+
+	assert.Equal(t, http.StatusAccepted, status)
+	assert.Equal(t, 5, size)
+}
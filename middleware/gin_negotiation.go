@@ -0,0 +1,14 @@
+package middleware
+
+import (
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/alehechka/go-jsonapi/middleware/nethttp"
+	"github.com/gin-gonic/gin"
+)
+
+// ContentNegotiation will short-circuit with 415 Unsupported Media Type or 406 Not Acceptable if the request headers
+// require extensions or media type parameters that are not supported: https://jsonapi.org/format/#content-negotiation-servers
+// Otherwise, the Content-Type header is set with the applied extensions and the provided profiles.
+func ContentNegotiation(supported jsonapi.MediaTypeParams) gin.HandlerFunc {
+	return Adapt(nethttp.ContentNegotiation(supported))
+}
@@ -0,0 +1,54 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/alehechka/go-jsonapi/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+const atomicExt = "https://jsonapi.org/ext/atomic"
+
+func Test_ContentNegotiation_Next(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request, _ = http.NewRequest("POST", "/example", nil)
+	c.Request.Header.Set(jsonapi.ContentType, jsonapi.MediaType)
+	c.Request.Header.Set(jsonapi.Accept, `application/vnd.api+json; ext="`+atomicExt+`"`)
+
+	middleware.ContentNegotiation(jsonapi.MediaTypeParams{
+		Ext:     []string{atomicExt},
+		Profile: []string{jsonapi.CursorPaginationProfile},
+	})(c)
+
+	assert.Equal(t, false, c.IsAborted())
+	assert.Equal(t, `application/vnd.api+json; ext="`+atomicExt+`"; profile="`+jsonapi.CursorPaginationProfile+`"`, c.Writer.Header().Get(jsonapi.ContentType))
+}
+
+func Test_ContentNegotiation_UnsupportedMediaType(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("POST", "/example", nil)
+	c.Request.Header.Set(jsonapi.ContentType, "application/vnd.api+json; charset=utf-8")
+
+	middleware.ContentNegotiation(jsonapi.MediaTypeParams{})(c)
+
+	assert.Equal(t, true, c.IsAborted())
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	assert.Equal(t, jsonapi.MediaType, w.Header().Get(jsonapi.ContentType))
+}
+
+func Test_ContentNegotiation_NotAcceptable(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/example", nil)
+	c.Request.Header.Set(jsonapi.Accept, `application/vnd.api+json; ext="`+atomicExt+`"`)
+
+	middleware.ContentNegotiation(jsonapi.MediaTypeParams{})(c)
+
+	assert.Equal(t, true, c.IsAborted())
+	assert.Equal(t, http.StatusNotAcceptable, w.Code)
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/alehechka/go-jsonapi/middleware/nethttp"
+	"github.com/gin-gonic/gin"
+)
+
+// Recovery will recover from any panic in the next handlers and write a 500 Internal Server Error document.
+// Panics with an error are converted by jsonapi.FromError, allowing handlers to panic with a jsonapi.Error.
+func Recovery(options nethttp.RecoveryOptions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// the remaining gin handlers must be aborted after a panic, which an adapted net/http middleware cannot do
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				c.Abort()
+				options.Recover(c.Writer, c.Request, recovered)
+			}
+		}()
+
+		c.Next()
+	}
+}
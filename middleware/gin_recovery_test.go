@@ -0,0 +1,46 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/alehechka/go-jsonapi/middleware"
+	"github.com/alehechka/go-jsonapi/middleware/nethttp"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Recovery(t *testing.T) {
+	isLogged := false
+	isCalled := false
+
+	engine := gin.New()
+	engine.Use(middleware.Recovery(nethttp.RecoveryOptions{
+		Log: func(r *http.Request, recovered interface{}, stack []byte) {
+			isLogged = true
+		},
+	}))
+	engine.Use(func(c *gin.Context) {
+		c.Next()
+		isCalled = true
+	})
+	engine.GET("/example", func(c *gin.Context) {
+		panic("something went wrong")
+	}, func(c *gin.Context) {
+		isCalled = true
+	})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest("GET", "/example", nil))
+
+	assert.Equal(t, true, isLogged)
+	assert.Equal(t, false, isCalled)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, jsonapi.MediaType, w.Header().Get(jsonapi.ContentType))
+	assert.JSONEq(t, `{
+		"errors": [{"status": "500", "title": "Internal Server Error."}],
+		"links": {"self": "http://example.com/example"}
+	}`, w.Body.String())
+}
@@ -0,0 +1,11 @@
+package middleware
+
+import (
+	"github.com/alehechka/go-jsonapi/middleware/nethttp"
+	"github.com/gin-gonic/gin"
+)
+
+// SupportedSort will short-circuit if a sort field that is not in the provided, supported fields is requested.
+func SupportedSort(supportedFields ...string) gin.HandlerFunc {
+	return Adapt(nethttp.SupportedSort(supportedFields...))
+}
@@ -0,0 +1,31 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SupportedSort_Abort(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/?sort=-createdAt,age", nil)
+
+	middleware.SupportedSort("createdAt", "name")(c)
+
+	assert.Equal(t, true, c.IsAborted())
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_SupportedSort_Next(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request, _ = http.NewRequest("GET", "/?sort=-createdAt,name", nil)
+
+	middleware.SupportedSort("createdAt", "name")(c)
+
+	assert.Equal(t, false, c.IsAborted())
+}
@@ -0,0 +1,15 @@
+package nethttp
+
+import (
+	"net/http"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+)
+
+// ContentType is a middleware function to set the Content-Type header to the official JSON:API header.
+func ContentType(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(jsonapi.ContentType, jsonapi.MediaType)
+		next.ServeHTTP(w, r)
+	})
+}
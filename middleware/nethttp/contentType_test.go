@@ -0,0 +1,28 @@
+package nethttp_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/alehechka/go-jsonapi/middleware/nethttp"
+	"github.com/stretchr/testify/assert"
+)
+
+// nextHandler records whether the next handler of the middleware chain was called
+func nextHandler(isCalled *bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*isCalled = true
+	})
+}
+
+func Test_ContentType(t *testing.T) {
+	isCalled := false
+	w := httptest.NewRecorder()
+
+	nethttp.ContentType(nextHandler(&isCalled)).ServeHTTP(w, httptest.NewRequest("GET", "/example", nil))
+
+	assert.Equal(t, true, isCalled)
+	assert.Equal(t, jsonapi.MediaType, w.Header().Get(jsonapi.ContentType))
+}
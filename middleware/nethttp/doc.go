@@ -0,0 +1,3 @@
+// Package nethttp is a collection of JSON:API middleware for the standard net/http package,
+// compatible with any router that accepts func(http.Handler) http.Handler middleware.
+package nethttp
@@ -0,0 +1,26 @@
+package nethttp
+
+import (
+	"net/http"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+)
+
+// ContentNegotiation will short-circuit with 415 Unsupported Media Type or 406 Not Acceptable if the request headers
+// require extensions or media type parameters that are not supported: https://jsonapi.org/format/#content-negotiation-servers
+// Otherwise, the Content-Type header is set with the applied extensions and the provided profiles.
+func ContentNegotiation(supported jsonapi.MediaTypeParams) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			applied, errs := jsonapi.NegotiateContent(r)(supported)
+
+			if errs.HasErrors() {
+				jsonapi.Write(w, r, jsonapi.Response{Errors: errs})
+				return
+			}
+
+			w.Header().Set(jsonapi.ContentType, applied.String())
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package nethttp_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/alehechka/go-jsonapi/middleware/nethttp"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ContentNegotiation_Next(t *testing.T) {
+	isCalled := false
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/example", nil)
+	r.Header.Set(jsonapi.Accept, jsonapi.MediaType)
+
+	nethttp.ContentNegotiation(jsonapi.MediaTypeParams{Profile: []string{jsonapi.CursorPaginationProfile}})(nextHandler(&isCalled)).ServeHTTP(w, r)
+
+	assert.Equal(t, true, isCalled)
+	assert.Equal(t, `application/vnd.api+json; profile="`+jsonapi.CursorPaginationProfile+`"`, w.Header().Get(jsonapi.ContentType))
+}
+
+func Test_ContentNegotiation_NotAcceptable(t *testing.T) {
+	isCalled := false
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/example", nil)
+	r.Header.Set(jsonapi.Accept, "application/vnd.api+json; charset=utf-8")
+
+	nethttp.ContentNegotiation(jsonapi.MediaTypeParams{})(nextHandler(&isCalled)).ServeHTTP(w, r)
+
+	assert.Equal(t, false, isCalled)
+	assert.Equal(t, http.StatusNotAcceptable, w.Code)
+	assert.Equal(t, jsonapi.MediaType, w.Header().Get(jsonapi.ContentType))
+}
@@ -0,0 +1,55 @@
+package nethttp
+
+import (
+	"net/http"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+)
+
+// SupportedPagination will short-circuit if a pagination query that is not in the provided, supported options.
+func SupportedPagination(supportedOptions ...jsonapi.PaginationOption) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			errs := jsonapi.CheckSupportedPagination(r)(supportedOptions...)
+
+			if errs.HasErrors() {
+				jsonapi.Write(w, r, jsonapi.Response{Errors: errs})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// UnsupportedPagination will short-circuit if one of the provided, unsupported query options is provided in the request.
+func UnsupportedPagination(unsupportedOptions ...jsonapi.PaginationOption) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			errs := jsonapi.CheckUnsupportedPagination(r)(unsupportedOptions...)
+
+			if errs.HasErrors() {
+				jsonapi.Write(w, r, jsonapi.Response{Errors: errs})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// MaximumPaginationSize will short-circuit if one of the provided pagination query options exceeds the provided maximum.
+func MaximumPaginationSize(maxSize int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			errs := jsonapi.CheckExceedsMaximumPaginationSize(r)(maxSize)
+
+			if errs.HasErrors() {
+				jsonapi.Write(w, r, jsonapi.Response{Errors: errs})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
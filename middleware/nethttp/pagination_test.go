@@ -0,0 +1,77 @@
+package nethttp_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/alehechka/go-jsonapi/middleware/nethttp"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SupportedPagination_Abort(t *testing.T) {
+	isCalled := false
+	w := httptest.NewRecorder()
+
+	nethttp.SupportedPagination(jsonapi.PageOffset)(nextHandler(&isCalled)).ServeHTTP(w, httptest.NewRequest("GET", "/?page[offset]=10&page[size]=10", nil))
+
+	assert.Equal(t, false, isCalled)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, jsonapi.MediaType, w.Header().Get(jsonapi.ContentType))
+
+	var response struct {
+		Errors []map[string]interface{} `json:"errors"`
+	}
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, len(response.Errors))
+	assert.Equal(t, map[string]interface{}{"parameter": "page[size]"}, response.Errors[0]["source"])
+}
+
+func Test_SupportedPagination_Next(t *testing.T) {
+	isCalled := false
+	w := httptest.NewRecorder()
+
+	nethttp.SupportedPagination(jsonapi.PageOffset, jsonapi.PageLimit)(nextHandler(&isCalled)).ServeHTTP(w, httptest.NewRequest("GET", "/?page[offset]=10&page[limit]=10", nil))
+
+	assert.Equal(t, true, isCalled)
+}
+
+func Test_UnsupportedPagination_Abort(t *testing.T) {
+	isCalled := false
+	w := httptest.NewRecorder()
+
+	nethttp.UnsupportedPagination(jsonapi.PageOffset)(nextHandler(&isCalled)).ServeHTTP(w, httptest.NewRequest("GET", "/?page[offset]=10&page[size]=10", nil))
+
+	assert.Equal(t, false, isCalled)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_UnsupportedPagination_Next(t *testing.T) {
+	isCalled := false
+	w := httptest.NewRecorder()
+
+	nethttp.UnsupportedPagination(jsonapi.PageAfter)(nextHandler(&isCalled)).ServeHTTP(w, httptest.NewRequest("GET", "/?page[offset]=10&page[size]=10", nil))
+
+	assert.Equal(t, true, isCalled)
+}
+
+func Test_MaximumPaginationSize_Abort(t *testing.T) {
+	isCalled := false
+	w := httptest.NewRecorder()
+
+	nethttp.MaximumPaginationSize(100)(nextHandler(&isCalled)).ServeHTTP(w, httptest.NewRequest("GET", "/?page[limit]=1000&page[size]=10", nil))
+
+	assert.Equal(t, false, isCalled)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_MaximumPaginationSize_Next(t *testing.T) {
+	isCalled := false
+	w := httptest.NewRecorder()
+
+	nethttp.MaximumPaginationSize(100)(nextHandler(&isCalled)).ServeHTTP(w, httptest.NewRequest("GET", "/?page[limit]=100&page[size]=10", nil))
+
+	assert.Equal(t, true, isCalled)
+}
@@ -0,0 +1,78 @@
+package nethttp
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+)
+
+// RecoveryOptions configures the Recovery middleware
+type RecoveryOptions struct {
+	// Log is called with every recovered panic and the stack trace, defaults to the standard logger
+	Log func(r *http.Request, recovered interface{}, stack []byte)
+	// IncludeStack adds the panic and stack trace to the Error.Meta of errors without meta, it should never be enabled in production
+	IncludeStack bool
+}
+
+// Recovery will recover from any panic in the next handlers and write a 500 Internal Server Error document.
+// Panics with an error are converted by jsonapi.FromError, allowing handlers to panic with a jsonapi.Error.
+func Recovery(options RecoveryOptions) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					options.Recover(w, r, recovered)
+				}
+			}()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Recover logs the recovered panic and writes the resulting error document, it must be called from the deferred function that recovered.
+// http.ErrAbortHandler is panicked again to abort the response as intended.
+func (options RecoveryOptions) Recover(w http.ResponseWriter, r *http.Request, recovered interface{}) {
+	if recovered == http.ErrAbortHandler {
+		panic(recovered)
+	}
+
+	stack := debug.Stack()
+	if options.Log != nil {
+		options.Log(r, recovered, stack)
+	} else {
+		log.Printf("panic recovered: %v\n%s", recovered, stack)
+	}
+
+	jsonapi.Write(w, r, jsonapi.Response{Errors: options.errors(recovered, stack)})
+}
+
+func (options RecoveryOptions) errors(recovered interface{}, stack []byte) jsonapi.Errors {
+	errs := jsonapi.Errors{
+		{
+			Title:  "Internal Server Error.",
+			Status: jsonapi.Status(http.StatusInternalServerError),
+		},
+	}
+
+	if err, isError := recovered.(error); isError {
+		errs = jsonapi.FromError(err)
+	}
+
+	if options.IncludeStack {
+		for index := range errs {
+			if errs[index].Meta != nil {
+				continue
+			}
+			errs[index].Meta = jsonapi.Meta{
+				"panic": fmt.Sprint(recovered),
+				"stack": string(stack),
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,89 @@
+package nethttp_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/alehechka/go-jsonapi/middleware/nethttp"
+	"github.com/stretchr/testify/assert"
+)
+
+func panicHandler(recovered interface{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(recovered)
+	})
+}
+
+func Test_Recovery(t *testing.T) {
+	var logged interface{}
+	w := httptest.NewRecorder()
+
+	nethttp.Recovery(nethttp.RecoveryOptions{
+		Log: func(r *http.Request, recovered interface{}, stack []byte) {
+			logged = recovered
+		},
+	})(panicHandler(errors.New("database password is hunter2"))).ServeHTTP(w, httptest.NewRequest("GET", "/example", nil))
+
+	assert.Equal(t, errors.New("database password is hunter2"), logged)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, jsonapi.MediaType, w.Header().Get(jsonapi.ContentType))
+	assert.JSONEq(t, `{
+		"errors": [
+			{
+				"status": "500",
+				"title": "Internal Server Error."
+			}
+		],
+		"links": {"self": "http://example.com/example"}
+	}`, w.Body.String())
+}
+
+func Test_Recovery_Error(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	nethttp.Recovery(nethttp.RecoveryOptions{
+		Log: func(r *http.Request, recovered interface{}, stack []byte) {},
+	})(panicHandler(jsonapi.Error{Title: "Service Unavailable.", Status: jsonapi.Status(http.StatusServiceUnavailable)})).ServeHTTP(w, httptest.NewRequest("GET", "/example", nil))
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func Test_Recovery_IncludeStack(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	nethttp.Recovery(nethttp.RecoveryOptions{
+		Log:          func(r *http.Request, recovered interface{}, stack []byte) {},
+		IncludeStack: true,
+	})(panicHandler("something went wrong")).ServeHTTP(w, httptest.NewRequest("GET", "/example", nil))
+
+	var response struct {
+		Errors []struct {
+			Meta map[string]string `json:"meta"`
+		} `json:"errors"`
+	}
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, "something went wrong", response.Errors[0].Meta["panic"])
+	assert.Contains(t, response.Errors[0].Meta["stack"], "panicHandler")
+}
+
+func Test_Recovery_Next(t *testing.T) {
+	isCalled := false
+	w := httptest.NewRecorder()
+
+	nethttp.Recovery(nethttp.RecoveryOptions{})(nextHandler(&isCalled)).ServeHTTP(w, httptest.NewRequest("GET", "/example", nil))
+
+	assert.Equal(t, true, isCalled)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func Test_Recovery_AbortHandler(t *testing.T) {
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		nethttp.Recovery(nethttp.RecoveryOptions{})(panicHandler(http.ErrAbortHandler)).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/example", nil))
+	})
+}
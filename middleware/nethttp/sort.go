@@ -0,0 +1,23 @@
+package nethttp
+
+import (
+	"net/http"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+)
+
+// SupportedSort will short-circuit if a sort field that is not in the provided, supported fields is requested.
+func SupportedSort(supportedFields ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			errs := jsonapi.GetSort(r).VerifyFields(supportedFields...)
+
+			if errs.HasErrors() {
+				jsonapi.Write(w, r, jsonapi.Response{Errors: errs})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
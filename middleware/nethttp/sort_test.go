@@ -0,0 +1,29 @@
+package nethttp_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/middleware/nethttp"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SupportedSort_Abort(t *testing.T) {
+	isCalled := false
+	w := httptest.NewRecorder()
+
+	nethttp.SupportedSort("createdAt", "name")(nextHandler(&isCalled)).ServeHTTP(w, httptest.NewRequest("GET", "/?sort=-createdAt,age", nil))
+
+	assert.Equal(t, false, isCalled)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_SupportedSort_Next(t *testing.T) {
+	isCalled := false
+	w := httptest.NewRecorder()
+
+	nethttp.SupportedSort("createdAt", "name")(nextHandler(&isCalled)).ServeHTTP(w, httptest.NewRequest("GET", "/?sort=-createdAt,name", nil))
+
+	assert.Equal(t, true, isCalled)
+}
@@ -0,0 +1,75 @@
+// Package validation converts the errors of github.com/go-playground/validator into JSON:API Errors
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/go-playground/validator/v10"
+)
+
+// FromError converts the validator.ValidationErrors of the validated node into Errors with a Source.Pointer to each invalid member
+// of the request document, ex. /data/attributes/firstName. Any other error is converted with jsonapi.FromError.
+func FromError(err error, node interface{}) jsonapi.Errors {
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return jsonapi.FromError(err)
+	}
+
+	errs := make(jsonapi.Errors, 0, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		errs = append(errs, fromFieldError(fieldErr, node))
+	}
+
+	return errs
+}
+
+func fromFieldError(fieldErr validator.FieldError, node interface{}) jsonapi.Error {
+	pointer := jsonapi.ResourcePointer(node, structPath(fieldErr.StructNamespace())...)
+
+	if member := strings.TrimPrefix(pointer, relationshipsPointer); member != pointer {
+		return jsonapi.InvalidRelationship(pointer, detail(member, fieldErr))
+	}
+
+	if member := strings.TrimPrefix(pointer, attributesPointer); member != pointer {
+		return jsonapi.InvalidAttribute(pointer, detail(member, fieldErr))
+	}
+
+	return jsonapi.InvalidAttribute(pointer, detail(strings.TrimPrefix(pointer, dataPointer), fieldErr))
+}
+
+const (
+	dataPointer          string = "/data/"
+	attributesPointer    string = "/data/attributes/"
+	relationshipsPointer string = "/data/relationships/"
+)
+
+func detail(member string, fieldErr validator.FieldError) string {
+	if len(fieldErr.Param()) > 0 {
+		return fmt.Sprintf("%s failed on the '%s=%s' validation", member, fieldErr.Tag(), fieldErr.Param())
+	}
+
+	return fmt.Sprintf("%s failed on the '%s' validation", member, fieldErr.Tag())
+}
+
+// structPath splits the struct namespace, without the name of the validated struct, into field names and indexes: Person.Tags[0] => Tags, 0
+func structPath(namespace string) (path []string) {
+	segments := strings.Split(namespace, ".")
+
+	for _, segment := range segments[1:] {
+		index := strings.Index(segment, "[")
+		if index < 0 {
+			path = append(path, segment)
+			continue
+		}
+
+		path = append(path, segment[:index])
+		for _, key := range strings.Split(strings.TrimSuffix(segment[index+1:], "]"), "][") {
+			path = append(path, key)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,83 @@
+package validation_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/alehechka/go-jsonapi/jsonapi"
+	"github.com/alehechka/go-jsonapi/validation"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+type Address struct {
+	City string `json:"city" validate:"required"`
+}
+
+type Person struct {
+	PersonID  string   `json:"-"`
+	FirstName string   `json:"firstName" validate:"required"`
+	Age       int      `json:"age" validate:"min=18"`
+	Address   Address  `json:"address"`
+	Emails    []string `json:"emails" validate:"dive,email"`
+	CompanyID string   `json:"company" validate:"required"`
+}
+
+func (person Person) ID() string {
+	return person.PersonID
+}
+
+func (person Person) Type() string {
+	return "people"
+}
+
+func (person Person) Relationships() map[string]interface{} {
+	return map[string]interface{}{
+		"company": jsonapi.ResourceIdentifier{ID: person.CompanyID, Type: "companies"},
+	}
+}
+
+type TaggedPerson struct {
+	PersonID  string `jsonapi:"primary,people" validate:"required"`
+	FirstName string `jsonapi:"attr,firstName" validate:"required"`
+}
+
+func Test_FromError(t *testing.T) {
+	person := Person{Age: 16, Emails: []string{"john@example.com", "john"}}
+
+	errs := validation.FromError(validator.New().Struct(person), person)
+
+	assert.Equal(t, jsonapi.Errors{
+		jsonapi.InvalidAttribute("/data/attributes/firstName", "firstName failed on the 'required' validation"),
+		jsonapi.InvalidAttribute("/data/attributes/age", "age failed on the 'min=18' validation"),
+		jsonapi.InvalidAttribute("/data/attributes/address/city", "address/city failed on the 'required' validation"),
+		jsonapi.InvalidAttribute("/data/attributes/emails/1", "emails/1 failed on the 'email' validation"),
+		jsonapi.InvalidRelationship("/data/relationships/company", "company failed on the 'required' validation"),
+	}, errs)
+}
+
+func Test_FromError_Tagged(t *testing.T) {
+	person := TaggedPerson{}
+
+	errs := validation.FromError(validator.New().Struct(&person), &person)
+
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/id"}, errs[0].Source)
+	assert.Equal(t, jsonapi.ErrorSource{Pointer: "/data/attributes/firstName"}, errs[1].Source)
+}
+
+func Test_FromError_Valid(t *testing.T) {
+	person := TaggedPerson{PersonID: "1234", FirstName: "John"}
+
+	errs := validation.FromError(validator.New().Struct(person), person)
+
+	assert.False(t, errs.HasErrors())
+}
+
+func Test_FromError_Other(t *testing.T) {
+	errs := validation.FromError(errors.New("something went wrong"), Person{})
+
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, http.StatusInternalServerError, errs[0].StatusCode())
+}